@@ -17,11 +17,15 @@ limitations under the License.
 package app
 
 import (
+	"strings"
+
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
 
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 	"github.com/symcn/mid-operator/pkg/controllers"
 	"github.com/symcn/mid-operator/pkg/k8sclient"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
 	"github.com/symcn/mid-operator/pkg/option"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlmanager "sigs.k8s.io/controller-runtime/pkg/manager"
@@ -36,6 +40,10 @@ func NewControllerCmd(opt *option.GlobalManagerOption) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			PrintFlags(cmd.Flags())
 
+			if err := k8sutils.SetRecreatableKinds(ctlOpt.RecreateKinds...); err != nil {
+				klog.Fatalf("invalid recreate kinds err: %v", err)
+			}
+
 			cfg, err := ctrl.GetConfig()
 			if err != nil {
 				klog.Fatalf("unable to get cfg err: %v", err)
@@ -47,12 +55,14 @@ func NewControllerCmd(opt *option.GlobalManagerOption) *cobra.Command {
 
 			mgr, err := ctrlmanager.New(cfg, ctrlmanager.Options{
 				Scheme:                  k8sclient.GetScheme(),
+				MapperProvider:          k8sutils.NewCachedRESTMapper,
 				LeaderElection:          opt.EnableLeaderElection,
 				LeaderElectionNamespace: opt.LeaderElectionNamespace,
 				SyncPeriod:              &opt.ResyncPeriod,
-				MetricsBindAddress:      "0",
+				MetricsBindAddress:      ctlOpt.MetricsAddr,
 				HealthProbeBindAddress:  ":8090",
-				// Port:               9443,
+				Port:                    ctlOpt.WebhookPort,
+				CertDir:                 ctlOpt.WebhookCertDir,
 			})
 			if err != nil {
 				klog.Fatalf("unable to new manager err: %v", err)
@@ -66,6 +76,13 @@ func NewControllerCmd(opt *option.GlobalManagerOption) *cobra.Command {
 				klog.Fatalf("unable to register controllers to the manager err: %v", err)
 			}
 
+			if ctlOpt.EnableWebhook {
+				klog.Info("Setting up webhooks")
+				if err := devopsv1beta1.SetupWebhooksWithManager(mgr); err != nil {
+					klog.Fatalf("unable to register webhooks to the manager err: %v", err)
+				}
+			}
+
 			klog.Info("starting manager")
 			if err := mgr.Start(stopCh); err != nil {
 				klog.Fatalf("problem start running manager err: %v", err)
@@ -73,5 +90,18 @@ func NewControllerCmd(opt *option.GlobalManagerOption) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&ctlOpt.EnableIstio, "enable-istio", ctlOpt.EnableIstio, "Enable the Istio, MeshGateway and RemoteIstio controllers")
+	cmd.Flags().BoolVar(&ctlOpt.EnableSidecar, "enable-sidecar", ctlOpt.EnableSidecar, "Enable the Sidecar controller")
+	cmd.Flags().BoolVar(&ctlOpt.EnableWebhook, "enable-webhook", ctlOpt.EnableWebhook, "Enable the defaulting and validating webhooks of the Istio, MeshGateway and RemoteIstio resources")
+	cmd.Flags().IntVar(&ctlOpt.WebhookPort, "webhook-port", ctlOpt.WebhookPort, "The port the webhook server serves at")
+	cmd.Flags().StringVar(&ctlOpt.WebhookCertDir, "webhook-cert-dir", ctlOpt.WebhookCertDir, "The directory containing the serving certificate and key of the webhook server")
+	cmd.Flags().StringVar(&ctlOpt.MetricsAddr, "metrics-addr", ctlOpt.MetricsAddr, "The address the metrics endpoint binds to, 0 disables it")
+	cmd.Flags().StringSliceVar(&ctlOpt.RecreateKinds, "recreate-kinds", ctlOpt.RecreateKinds,
+		"The kinds deleted and re-created when one of their immutable fields changes, one of "+strings.Join(k8sutils.RecreatableKinds(), ", "))
+	cmd.Flags().DurationVar(&ctlOpt.ControlPlaneWaitTimeout, "control-plane-wait-timeout", ctlOpt.ControlPlaneWaitTimeout,
+		"How long a mesh gateway waits for istiod to be ready before it is reported as failed, 0 waits forever")
+	cmd.Flags().IntVar(&ctlOpt.ComponentThreadiness, "component-threadiness", ctlOpt.ComponentThreadiness,
+		"The number of components of an Istio resource reconciled at the same time")
+
 	return cmd
 }
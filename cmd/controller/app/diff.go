@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The symcn authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/symcn/mid-operator/pkg/k8sclient"
+	"github.com/symcn/mid-operator/pkg/option"
+	"github.com/symcn/mid-operator/pkg/render"
+)
+
+// NewCmdDiff returns a cobra command printing the changes the operator would make to the cluster for the resources of a file
+func NewCmdDiff(opt *option.GlobalManagerOption) *cobra.Command {
+	diffOpt := render.Options{}
+	cmd := &cobra.Command{
+		Use:   "diff FILE",
+		Short: "Print the changes the operator would make to the cluster",
+		Long: "Compare the objects the operator would create for the Istio and MeshGateway resources of the file " +
+			"with the ones of the cluster and print the objects to create, delete or update, along with the JSON patch " +
+			"of the updates. Nothing is written to the cluster. Use - to read the standard input.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var in io.Reader = os.Stdin
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				in = f
+			}
+
+			cfg, err := ctrl.GetConfig()
+			if err != nil {
+				return err
+			}
+			c, err := client.New(cfg, client.Options{Scheme: k8sclient.GetScheme()})
+			if err != nil {
+				return err
+			}
+			dc, err := dynamic.NewForConfig(cfg)
+			if err != nil {
+				return err
+			}
+
+			diffOpt.Namespace = opt.Namespace
+			if diffOpt.Namespace == "" {
+				diffOpt.Namespace = "istio-system"
+			}
+			out, err := render.Diff(in, c, dc, diffOpt)
+			if err != nil {
+				return err
+			}
+
+			_, err = os.Stdout.Write(out)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&diffOpt.IncludeCRDs, "include-crds", diffOpt.IncludeCRDs, "Compare the Istio CRDs as well")
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The symcn authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/symcn/mid-operator/pkg/option"
+	"github.com/symcn/mid-operator/pkg/render"
+)
+
+// NewCmdRender returns a cobra command printing the objects the operator would create for the resources of a file
+func NewCmdRender(opt *option.GlobalManagerOption) *cobra.Command {
+	renderOpt := render.Options{}
+	cmd := &cobra.Command{
+		Use:   "render FILE",
+		Short: "Print the objects the operator would create",
+		Long: "Print the objects the operator would create for the Istio, MeshGateway and RemoteIstio resources of the file " +
+			"as multi-document YAML, without connecting to a cluster. Use - to read the standard input.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var in io.Reader = os.Stdin
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				in = f
+			}
+
+			renderOpt.Namespace = opt.Namespace
+			if renderOpt.Namespace == "" {
+				renderOpt.Namespace = "istio-system"
+			}
+			out, err := render.Render(in, renderOpt)
+			if err != nil {
+				return err
+			}
+
+			_, err = os.Stdout.Write(out)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&renderOpt.IncludeCRDs, "include-crds", renderOpt.IncludeCRDs, "Print the Istio CRDs before the other objects")
+
+	return cmd
+}
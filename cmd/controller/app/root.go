@@ -73,6 +73,8 @@ func GetRootCmd(args []string) *cobra.Command {
 
 	rootCmd.AddCommand(NewControllerCmd(opt))
 	rootCmd.AddCommand(NewCmdVersion())
+	rootCmd.AddCommand(NewCmdRender(opt))
+	rootCmd.AddCommand(NewCmdDiff(opt))
 	return rootCmd
 }
 
@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type ConfigState string
@@ -12,9 +13,76 @@ const (
 	Reconciling     ConfigState = "Reconciling"
 	Available       ConfigState = "Available"
 	Unmanaged       ConfigState = "Unmanaged"
+	Upgrading       ConfigState = "Upgrading"
+	DryRun          ConfigState = "DryRun"
+	// WaitingForControlPlane gateways are not rolled out until istiod is ready to serve them
+	WaitingForControlPlane ConfigState = "WaitingForControlPlane"
 )
 
-const supportedIstioMinorVersionRegex = "^1.5"
+// IstioVersionAnnotation holds the control plane version the gateways created by the Istio controller are rolled out with
+const IstioVersionAnnotation = "devops.symcn.com/istio-version"
+
+// UnmanagedAnnotation pauses the reconciliation of the Istio or MeshGateway resource when set to "true",
+// the status is still reported and the resources are still cleaned up on deletion
+const UnmanagedAnnotation = "devops.symcn.com/unmanaged"
+
+// IsUnmanaged returns whether the reconciliation of the resource is paused by the UnmanagedAnnotation
+func IsUnmanaged(o metav1.Object) bool {
+	return o.GetAnnotations()[UnmanagedAnnotation] == "true"
+}
+
+// DefaultControlPlaneAnnotation marks the Istio resource of the control plane the mesh gateways without one
+// in their namespace belong to when set to "true"
+const DefaultControlPlaneAnnotation = "devops.symcn.com/default-control-plane"
+
+// IsDefaultControlPlane returns whether the Istio resource is the default control plane of the cluster
+func IsDefaultControlPlane(o metav1.Object) bool {
+	return o.GetAnnotations()[DefaultControlPlaneAnnotation] == "true"
+}
+
+// DryRunAnnotation makes the reconciliation of the Istio resource record the changes it would make into the status
+// instead of applying them when set to "true"
+const DryRunAnnotation = "devops.symcn.com/dry-run"
+
+// IsDryRun returns whether the reconciliation of the resource only records its changes because of the DryRunAnnotation
+func IsDryRun(o metav1.Object) bool {
+	return o.GetAnnotations()[DryRunAnnotation] == "true"
+}
+
+type ResourceChangeOperation string
+
+const (
+	ResourceChangeCreate ResourceChangeOperation = "Create"
+	ResourceChangeUpdate ResourceChangeOperation = "Update"
+	ResourceChangeDelete ResourceChangeOperation = "Delete"
+)
+
+// ResourceChange is a change the reconciliation would make to a resource
+type ResourceChange struct {
+	Operation ResourceChangeOperation `json:"operation"`
+	Kind      string                  `json:"kind"`
+	Namespace string                  `json:"namespace,omitempty"`
+	Name      string                  `json:"name"`
+	// JSON merge patch of an update
+	Patch string `json:"patch,omitempty"`
+}
+
+// GatewayEndpoint is an address a gateway is reachable at from outside of its cluster
+type GatewayEndpoint struct {
+	// Address is an IPv4 or IPv6 address, or a hostname
+	Address string `json:"address"`
+	// Ports the gateway is reachable on at the address
+	Ports []GatewayEndpointPort `json:"ports,omitempty"`
+}
+
+// GatewayEndpointPort is a port of the gateway service reachable at an address
+type GatewayEndpointPort struct {
+	Name string `json:"name,omitempty"`
+	// Port of the service
+	Port int32 `json:"port"`
+	// ExposedPort the service port is reachable on at the address, the node port of a NodePort service
+	ExposedPort int32 `json:"exposedPort"`
+}
 
 // IstioVersion stores the intended Istio version
 type IstioVersion string
@@ -121,13 +189,68 @@ type IstioCoreDNS struct {
 	PluginImage                              string `json:"pluginImage,omitempty"`
 }
 
+// PrometheusConfiguration defines config options for the Prometheus addon scraping the mesh
+type PrometheusConfiguration struct {
+	Enabled                               *bool `json:"enabled,omitempty"`
+	BaseK8sResourceConfigurationWithImage `json:",inline"`
+	// How long the samples are kept, e.g. 6h or 15d
+	// +kubebuilder:validation:Pattern=^[0-9]+(ms|s|m|h|d|w|y)$
+	Retention string `json:"retention,omitempty"`
+	// Persistent storage of the samples, they are kept in an emptyDir when not set
+	Storage *PrometheusStorageConfiguration `json:"storage,omitempty"`
+	// If set to true, ServiceMonitor and PodMonitor resources are created for an existing prometheus-operator
+	// instead of installing Prometheus. When not set, they are created if the prometheus-operator CRDs are present.
+	PrometheusOperator *bool `json:"prometheusOperator,omitempty"`
+	// Address of the Prometheus queried by the addons, defaults to the Prometheus installed by the operator.
+	// It has to be set when the metrics are collected by a prometheus-operator.
+	URL string `json:"url,omitempty"`
+}
+
+// PrometheusStorageConfiguration defines the persistent volume claim of Prometheus
+type PrometheusStorageConfiguration struct {
+	// Size of the volume, e.g. 10Gi
+	Size string `json:"size"`
+	// Storage class of the volume, the default storage class is used when not set
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// GrafanaConfiguration defines config options for the Grafana addon, provisioned with the Istio dashboards
+// of the Istio version and with the mesh Prometheus as its datasource
+type GrafanaConfiguration struct {
+	Enabled                               *bool `json:"enabled,omitempty"`
+	BaseK8sResourceConfigurationWithImage `json:",inline"`
+}
+
+type KialiAuthStrategy string
+
+const (
+	KialiAuthStrategyAnonymous KialiAuthStrategy = "anonymous"
+	KialiAuthStrategyToken     KialiAuthStrategy = "token"
+	KialiAuthStrategyOpenID    KialiAuthStrategy = "openid"
+	KialiAuthStrategyHeader    KialiAuthStrategy = "header"
+)
+
+// KialiConfiguration defines config options for the Kiali addon, wired to the mesh Prometheus, Grafana and tracing
+type KialiConfiguration struct {
+	Enabled                               *bool `json:"enabled,omitempty"`
+	BaseK8sResourceConfigurationWithImage `json:",inline"`
+	// Strategy used to authenticate the users of the Kiali console
+	// +kubebuilder:validation:Enum=anonymous;token;openid;header
+	AuthStrategy KialiAuthStrategy `json:"authStrategy,omitempty"`
+	// Namespaces Kiali has access to, regular expressions are allowed and "**" stands for every namespace
+	AccessibleNamespaces []string `json:"accessibleNamespaces,omitempty"`
+	// Address of the query service of the tracing backend, e.g. http://tracing.istio-system/jaeger.
+	// Defaults to the Jaeger query port of the zipkin host when the zipkin tracer is used.
+	TracingURL string `json:"tracingURL,omitempty"`
+}
+
 // PDBConfiguration holds Pod Disruption Budget related config options
 type PDBConfiguration struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
 type OutboundTrafficPolicyConfiguration struct {
-	// +kubebuilder:validation:Enum="ALLOW_ANY,REGISTRY_ONLY"
+	// +kubebuilder:validation:Enum=ALLOW_ANY;REGISTRY_ONLY
 	Mode string `json:"mode,omitempty"`
 }
 
@@ -187,7 +310,7 @@ type StrackdriverConfiguration struct {
 //
 type TracingConfiguration struct {
 	Enabled *bool `json:"enabled,omitempty"`
-	// +kubebuilder:validation:Enum="zipkin,lightstep,datadog,stackdriver"
+	// +kubebuilder:validation:Enum=zipkin;lightstep;datadog;stackdriver
 	Tracer       TracerType                `json:"tracer,omitempty"`
 	Zipkin       ZipkinConfiguration       `json:"zipkin,omitempty"`
 	Lightstep    LightstepConfiguration    `json:"lightstep,omitempty"`
@@ -198,7 +321,7 @@ type TracingConfiguration struct {
 type MeshGatewayConfiguration struct {
 	BaseK8sResourceConfigurationWithHPAWithoutImage `json:",inline"`
 	Labels                                          map[string]string `json:"labels,omitempty"`
-	// +kubebuilder:validation:Enum="ClusterIP,NodePort,LoadBalancer"
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort;LoadBalancer
 	ServiceType        corev1.ServiceType `json:"serviceType,omitempty"`
 	LoadBalancerIP     string             `json:"loadBalancerIP,omitempty"`
 	ServiceAnnotations map[string]string  `json:"serviceAnnotations,omitempty"`
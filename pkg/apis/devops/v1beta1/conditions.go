@@ -0,0 +1,99 @@
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType is the type of a status condition, one per component
+type ConditionType string
+
+const (
+	ConditionTypeBase           ConditionType = "Base"
+	ConditionTypeIstiod         ConditionType = "Istiod"
+	ConditionTypeCNI            ConditionType = "CNI"
+	ConditionTypeCoreDNS        ConditionType = "CoreDNS"
+	ConditionTypeProxyWasm      ConditionType = "ProxyWasm"
+	ConditionTypeIngressGateway ConditionType = "IngressGateway"
+	ConditionTypeEgressGateway  ConditionType = "EgressGateway"
+	ConditionTypeGateway        ConditionType = "Gateway"
+	ConditionTypeControlPlane   ConditionType = "ControlPlane"
+	ConditionTypeRemote         ConditionType = "Remote"
+	ConditionTypeAutoInjection  ConditionType = "AutoInjection"
+	ConditionTypePrometheus     ConditionType = "Prometheus"
+	ConditionTypeGrafana        ConditionType = "Grafana"
+	ConditionTypeKiali          ConditionType = "Kiali"
+)
+
+const (
+	ConditionReasonReconciled        = "Reconciled"
+	ConditionReasonReconcileFailed   = "ReconcileFailed"
+	ConditionReasonRolloutInProgress = "RolloutInProgress"
+	ConditionReasonAddressPending    = "AddressPending"
+	// a component the component depends on failed, the component was not reconciled
+	ConditionReasonDependencyFailed = "DependencyFailed"
+	// the gateway waits for istiod of its control plane to be ready
+	ConditionReasonWaitingForControlPlane = "WaitingForControlPlane"
+	// the control plane of a gateway could not be found, is not the one owning it
+	// or does not allow gateways in the namespace of the gateway
+	ConditionReasonControlPlaneNotFound = "ControlPlaneNotFound"
+	ConditionReasonControlPlaneMismatch = "ControlPlaneMismatch"
+	ConditionReasonNamespaceNotAllowed  = "NamespaceNotAllowed"
+)
+
+// Condition describes the state of a component at a certain point,
+// it follows the conventions of the upstream metav1.Condition
+type Condition struct {
+	// Type of the condition
+	Type ConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// Generation of the resource the condition was set based upon
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Last time the condition transitioned from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Reason of the last transition in CamelCase
+	Reason string `json:"reason,omitempty"`
+	// Human readable message with details about the last transition
+	Message string `json:"message,omitempty"`
+}
+
+// SetCondition adds or updates the condition of the same type,
+// the transition time only changes when the status of the condition does
+func SetCondition(conditions *[]Condition, condition Condition) {
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = metav1.Now()
+	}
+
+	existing := FindCondition(*conditions, condition.Type)
+	if existing == nil {
+		*conditions = append(*conditions, condition)
+		return
+	}
+
+	if existing.Status != condition.Status {
+		existing.Status = condition.Status
+		existing.LastTransitionTime = condition.LastTransitionTime
+	}
+	existing.ObservedGeneration = condition.ObservedGeneration
+	existing.Reason = condition.Reason
+	existing.Message = condition.Message
+}
+
+// FindCondition returns the condition of the given type, nil if it is not set
+func FindCondition(conditions []Condition, conditionType ConditionType) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// IsConditionTrue returns whether the condition of the given type is set and true
+func IsConditionTrue(conditions []Condition, conditionType ConditionType) bool {
+	condition := FindCondition(conditions, conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
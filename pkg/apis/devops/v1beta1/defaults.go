@@ -11,15 +11,10 @@ import (
 
 const (
 	defaultImageHub                   = "docker.io/istio"
-	defaultImageVersion               = "1.5.2"
+	defaultIstioMinorVersion          = "1.5"
 	defaultLogLevel                   = "default:info"
 	defaultMeshPolicy                 = PERMISSIVE
-	defaultPilotImage                 = defaultImageHub + "/" + "pilot" + ":" + defaultImageVersion
-	defaultSidecarInjectorImage       = defaultImageHub + "/" + "sidecar_injector" + ":" + defaultImageVersion
-	defaultProxyImage                 = defaultImageHub + "/" + "proxyv2" + ":" + defaultImageVersion
-	defaultProxyInitImage             = defaultImageHub + "/" + "proxyv2" + ":" + defaultImageVersion
 	defaultProxyCoreDumpImage         = "busybox"
-	defaultInitCNIImage               = defaultImageHub + "/" + "install-cni:" + defaultImageVersion
 	defaultCoreDNSImage               = "coredns/coredns:1.6.2"
 	defaultCoreDNSPluginImage         = defaultImageHub + "/coredns-plugin:0.2-istio-1.1"
 	defaultIncludeIPRanges            = "*"
@@ -43,6 +38,11 @@ const (
 	defaultEnvoyAccessLogEncoding     = "TEXT"
 	defaultClusterName                = "Kubernetes"
 	defaultNetworkName                = "local-network"
+	defaultPrometheusImage            = "prom/prometheus:v2.15.1"
+	defaultPrometheusRetention        = "6h"
+	defaultGrafanaImage               = "grafana/grafana:6.7.4"
+	defaultKialiImage                 = "quay.io/kiali/kiali:v1.18"
+	defaultKialiAuthStrategy          = KialiAuthStrategyToken
 )
 
 var defaultResources = &apiv1.ResourceRequirements{
@@ -87,7 +87,16 @@ var defaultEgressGatewayPorts = []apiv1.ServicePort{
 	{Port: 15443, Protocol: apiv1.ProtocolTCP, TargetPort: intstr.FromInt(15443), Name: "tls"},
 }
 
+// istioImage returns the default image of an Istio component for the version
+func istioImage(name string, version IstioVersion) string {
+	return defaultImageHub + "/" + name + ":" + version.ImageTag()
+}
+
 func SetDefaults(config *Istio) {
+	if config.Spec.Version == "" {
+		config.Spec.Version = IstioVersion(supportedIstioVersions[defaultIstioMinorVersion])
+	}
+
 	// MeshPolicy config
 	if config.Spec.MeshPolicy.MTLSMode == "" {
 		config.Spec.MeshPolicy.MTLSMode = defaultMeshPolicy
@@ -129,13 +138,28 @@ func SetDefaults(config *Istio) {
 	if config.Spec.Istiod.Enabled == nil {
 		config.Spec.Istiod.Enabled = utils.BoolPointer(true)
 	}
+	for i := range config.Spec.Istiod.Revisions {
+		revision := &config.Spec.Istiod.Revisions[i]
+		if revision.Version == "" {
+			revision.Version = config.Spec.Version
+		}
+		if revision.Image == nil {
+			revision.Image = utils.StrPointer(istioImage("pilot", revision.Version))
+		}
+		if revision.ProxyImage == "" {
+			revision.ProxyImage = istioImage("proxyv2", revision.Version)
+		}
+		if revision.ReplicaCount == nil {
+			revision.ReplicaCount = utils.IntPointer(defaultReplicaCount)
+		}
+	}
 
 	// Pilot config
 	if config.Spec.Pilot.Enabled == nil {
 		config.Spec.Pilot.Enabled = utils.BoolPointer(true)
 	}
 	if config.Spec.Pilot.Image == nil {
-		config.Spec.Pilot.Image = utils.StrPointer(defaultPilotImage)
+		config.Spec.Pilot.Image = utils.StrPointer(istioImage("pilot", config.Spec.Version))
 	}
 	if config.Spec.Pilot.Sidecar == nil {
 		config.Spec.Pilot.Sidecar = utils.BoolPointer(true)
@@ -218,7 +242,7 @@ func SetDefaults(config *Istio) {
 		config.Spec.SidecarInjector.AutoInjectionPolicyEnabled = utils.BoolPointer(true)
 	}
 	if config.Spec.SidecarInjector.Image == nil {
-		config.Spec.SidecarInjector.Image = utils.StrPointer(defaultSidecarInjectorImage)
+		config.Spec.SidecarInjector.Image = utils.StrPointer(istioImage("sidecar_injector", config.Spec.Version))
 	}
 	if config.Spec.SidecarInjector.ReplicaCount == nil {
 		config.Spec.SidecarInjector.ReplicaCount = utils.IntPointer(defaultReplicaCount)
@@ -227,7 +251,7 @@ func SetDefaults(config *Istio) {
 		config.Spec.SidecarInjector.InitCNIConfiguration.Enabled = utils.BoolPointer(false)
 	}
 	if config.Spec.SidecarInjector.InitCNIConfiguration.Image == "" {
-		config.Spec.SidecarInjector.InitCNIConfiguration.Image = defaultInitCNIImage
+		config.Spec.SidecarInjector.InitCNIConfiguration.Image = istioImage("install-cni", config.Spec.Version)
 	}
 	if config.Spec.SidecarInjector.InitCNIConfiguration.BinDir == "" {
 		config.Spec.SidecarInjector.InitCNIConfiguration.BinDir = defaultInitCNIBinDir
@@ -279,11 +303,11 @@ func SetDefaults(config *Istio) {
 
 	// Proxy config
 	if config.Spec.Proxy.Image == "" {
-		config.Spec.Proxy.Image = defaultProxyImage
+		config.Spec.Proxy.Image = istioImage("proxyv2", config.Spec.Version)
 	}
 	// Proxy Init config
 	if config.Spec.ProxyInit.Image == "" {
-		config.Spec.ProxyInit.Image = defaultProxyInitImage
+		config.Spec.ProxyInit.Image = istioImage("proxyv2", config.Spec.Version)
 	}
 	if config.Spec.Proxy.AccessLogFile == nil {
 		config.Spec.Proxy.AccessLogFile = utils.StrPointer(defaultEnvoyAccessLogFile)
@@ -406,6 +430,39 @@ func SetDefaults(config *Istio) {
 		config.Spec.IstioCoreDNS.ReplicaCount = utils.IntPointer(defaultReplicaCount)
 	}
 
+	// Prometheus addon
+	if config.Spec.Prometheus.Enabled == nil {
+		config.Spec.Prometheus.Enabled = utils.BoolPointer(false)
+	}
+	if config.Spec.Prometheus.Image == nil {
+		config.Spec.Prometheus.Image = utils.StrPointer(defaultPrometheusImage)
+	}
+	if config.Spec.Prometheus.Retention == "" {
+		config.Spec.Prometheus.Retention = defaultPrometheusRetention
+	}
+
+	// Grafana addon
+	if config.Spec.Grafana.Enabled == nil {
+		config.Spec.Grafana.Enabled = utils.BoolPointer(false)
+	}
+	if config.Spec.Grafana.Image == nil {
+		config.Spec.Grafana.Image = utils.StrPointer(defaultGrafanaImage)
+	}
+
+	// Kiali addon
+	if config.Spec.Kiali.Enabled == nil {
+		config.Spec.Kiali.Enabled = utils.BoolPointer(false)
+	}
+	if config.Spec.Kiali.Image == nil {
+		config.Spec.Kiali.Image = utils.StrPointer(defaultKialiImage)
+	}
+	if config.Spec.Kiali.AuthStrategy == "" {
+		config.Spec.Kiali.AuthStrategy = defaultKialiAuthStrategy
+	}
+	if len(config.Spec.Kiali.AccessibleNamespaces) == 0 {
+		config.Spec.Kiali.AccessibleNamespaces = []string{"**"}
+	}
+
 	if config.Spec.ImagePullPolicy == "" {
 		config.Spec.ImagePullPolicy = defaultImagePullPolicy
 	}
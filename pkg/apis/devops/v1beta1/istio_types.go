@@ -32,14 +32,42 @@ type LoggingConfiguration struct {
 // MeshPolicyConfiguration configures the default MeshPolicy resource
 type MeshPolicyConfiguration struct {
 	// MTLSMode sets the mesh-wide mTLS policy
-	// +kubebuilder:validation:Enum="STRICT,PERMISSIVE,DISABLED"
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE;DISABLED
 	MTLSMode MTLSMode `json:"mtlsMode,omitempty"`
+	// Namespaces overriding the mesh-wide mTLS policy
+	Namespaces []NamespaceMTLSConfiguration `json:"namespaces,omitempty"`
+}
+
+// NamespaceMTLSConfiguration overrides the mesh-wide mTLS policy in a namespace
+type NamespaceMTLSConfiguration struct {
+	Namespace string `json:"namespace"`
+	// MTLSMode sets the mTLS policy of the namespace
+	// +kubebuilder:validation:Enum=STRICT;PERMISSIVE;DISABLED
+	MTLSMode MTLSMode `json:"mtlsMode"`
 }
 
 // IstiodConfiguration defines config options for Istiod
 type IstiodConfiguration struct {
 	Enabled             *bool `json:"enabled,omitempty"`
 	MultiClusterSupport *bool `json:"multiClusterSupport,omitempty"`
+	// Additional istiod revisions running side by side with the default one,
+	// namespaces labeled with istio.io/rev=<name> are injected by the matching revision
+	Revisions []IstiodRevision `json:"revisions,omitempty"`
+}
+
+// IstiodRevision defines an additional istiod deployment serving the namespaces opted into the revision
+type IstiodRevision struct {
+	// Name of the revision, used as the value of the istio.io/rev namespace label and as the name suffix of its resources
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Istio version of the revision, defaults to the version of the control plane
+	// +kubebuilder:validation:Pattern=^1\.(5|6)(\.[0-9]+)?$
+	Version IstioVersion `json:"version,omitempty"`
+	// Pilot image of the revision, defaults to the pilot image of the version
+	Image *string `json:"image,omitempty"`
+	// Proxy image injected by the revision, defaults to the proxy image of the version
+	ProxyImage   string `json:"proxyImage,omitempty"`
+	ReplicaCount *int32 `json:"replicaCount,omitempty"`
 }
 
 // PilotConfiguration defines config options for Pilot
@@ -56,7 +84,7 @@ type PilotConfiguration struct {
 	// Currently, two providers are supported: "kubernetes" and "istiod".
 	// As some platforms may not have kubernetes signing APIs,
 	// Istiod is the default
-	// +kubebuilder:validation:Enum="kubernetes,istiod"
+	// +kubebuilder:validation:Enum=kubernetes;istiod
 	CertProvider PilotCertProviderType `json:"certProvider,omitempty"`
 
 	// If present will be appended at the end of the initial/preconfigured container arguments
@@ -198,6 +226,23 @@ type GatewaysConfiguration struct {
 	IngressConfig GatewayConfiguration    `json:"ingress,omitempty"`
 	EgressConfig  GatewayConfiguration    `json:"egress,omitempty"`
 	K8sIngress    K8sIngressConfiguration `json:"k8singress,omitempty"`
+	// Namespaces other than the one of the control plane the mesh gateways belonging to it can be created in,
+	// "*" allows every namespace
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// IsNamespaceAllowed returns whether the mesh gateways belonging to the control plane can be created in the namespace
+func (in *Istio) IsNamespaceAllowed(namespace string) bool {
+	if namespace == in.Namespace {
+		return true
+	}
+	for _, allowed := range in.Spec.Gateways.AllowedNamespaces {
+		if allowed == "*" || allowed == namespace {
+			return true
+		}
+	}
+
+	return false
 }
 
 type EnvoyStatsD struct {
@@ -207,7 +252,7 @@ type EnvoyStatsD struct {
 }
 
 type TLSSettings struct {
-	// +kubebuilder:validation:Enum="DISABLE,SIMPLE,MUTUAL,ISTIO_MUTUAL"
+	// +kubebuilder:validation:Enum=DISABLE;SIMPLE;MUTUAL;ISTIO_MUTUAL
 	Mode              string   `json:"mode,omitempty"`
 	ClientCertificate string   `json:"clientCertificate,omitempty"`
 	PrivateKey        string   `json:"privateKey,omitempty"`
@@ -237,7 +282,7 @@ type ProxyConfiguration struct {
 	// Options:
 	//   "" - disables access log
 	//   "/dev/stdout" - enables access log
-	// +kubebuilder:validation:Enum=",/dev/stdout"
+	// +kubebuilder:validation:Enum="";/dev/stdout
 	AccessLogFile *string `json:"accessLogFile,omitempty"`
 	// Configure how and what fields are displayed in sidecar access log. Setting to
 	// empty string will result in default log format.
@@ -247,7 +292,7 @@ type ProxyConfiguration struct {
 	// example: '{"start_time": "%START_TIME%", "req_method": "%REQ(:METHOD)%"}'
 	AccessLogFormat *string `json:"accessLogFormat,omitempty"`
 	// Configure the access log for sidecar to JSON or TEXT.
-	// +kubebuilder:validation:Enum="JSON,TEXT"
+	// +kubebuilder:validation:Enum=JSON;TEXT
 	AccessLogEncoding *string `json:"accessLogEncoding,omitempty"`
 	// If set to true, istio-proxy container will have privileged securityContext
 	Privileged bool `json:"privileged,omitempty"`
@@ -257,7 +302,7 @@ type ProxyConfiguration struct {
 	CoreDumpImage string `json:"coreDumpImage,omitempty"`
 	// Log level for proxy, applies to gateways and sidecars. If left empty, "warning" is used.
 	// Expected values are: trace|debug|info|warning|error|critical|off
-	// +kubebuilder:validation:Enum="trace,debug,info,warning,error,critical,off"
+	// +kubebuilder:validation:Enum=trace;debug;info;warning;error;critical;off
 	LogLevel string `json:"logLevel,omitempty"`
 	// Per Component log level for proxy, applies to gateways and sidecars. If a component level is
 	// not set, then the "LogLevel" will be used. If left empty, "misc:error" is used.
@@ -281,7 +326,8 @@ type ProxyConfiguration struct {
 
 // IstioSpec defines the desired state of Istio
 type IstioSpec struct {
-	// Contains the intended Istio version validation:Pattern=^1.5
+	// Contains the intended Istio version, either a minor version like 1.6 or an exact one like 1.6.0
+	// +kubebuilder:validation:Pattern=^1\.(5|6)(\.[0-9]+)?$
 	Version IstioVersion `json:"version"`
 
 	// Logging configurations
@@ -305,6 +351,9 @@ type IstioSpec struct {
 	// List of namespaces to label with sidecar auto injection enabled
 	AutoInjectionNamespaces []string `json:"autoInjectionNamespaces,omitempty"`
 
+	// Namespaces matching the selector are labeled with sidecar auto injection enabled as well
+	AutoInjectionNamespaceSelector *metav1.LabelSelector `json:"autoInjectionNamespaceSelector,omitempty"`
+
 	// ControlPlaneSecurityEnabled control plane services are communicating through mTLS
 	ControlPlaneSecurityEnabled bool `json:"controlPlaneSecurityEnabled,omitempty"`
 
@@ -367,7 +416,7 @@ type IstioSpec struct {
 	Tracing TracingConfiguration `json:"tracing,omitempty"`
 
 	// ImagePullPolicy describes a policy for if/when to pull a container image
-	// +kubebuilder:validation:Enum="Always,Never,IfNotPresent"
+	// +kubebuilder:validation:Enum=Always;Never;IfNotPresent
 	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
 
 	// If set to true, the pilot and citadel mtls will be exposed on the
@@ -383,6 +432,15 @@ type IstioSpec struct {
 	// Istio CoreDNS provides DNS resolution for services in multi mesh setups
 	IstioCoreDNS IstioCoreDNS `json:"istioCoreDNS,omitempty"`
 
+	// Prometheus scraping istiod, the gateways and the sidecars
+	Prometheus PrometheusConfiguration `json:"prometheus,omitempty"`
+
+	// Grafana with the Istio dashboards
+	Grafana GrafanaConfiguration `json:"grafana,omitempty"`
+
+	// Kiali showing the topology of the mesh
+	Kiali KialiConfiguration `json:"kiali,omitempty"`
+
 	// Locality based load balancing distribution or failover settings.
 	LocalityLB *LocalityLBConfiguration `json:"localityLB,omitempty"`
 
@@ -424,7 +482,7 @@ type IstioSpec struct {
 
 	// Configure the policy for validating JWT.
 	// Currently, two options are supported: "third-party-jwt" and "first-party-jwt".
-	// +kubebuilder:validation:Enum="third-party-jwt,first-party-jwt"
+	// +kubebuilder:validation:Enum=third-party-jwt;first-party-jwt
 	JWTPolicy JWTPolicyType `json:"jwtPolicy,omitempty"`
 
 	// The customized CA address to retrieve certificates for the pods in the cluster.
@@ -432,11 +490,31 @@ type IstioSpec struct {
 	CAAddress string `json:"caAddress,omitempty"`
 }
 
+// MTLSStatus reports the mTLS modes in effect
+type MTLSStatus struct {
+	// Mode of the mesh-wide policy, in effect in every namespace without an override
+	Mode MTLSMode `json:"mode,omitempty"`
+	// Modes of the namespaces overriding the mesh-wide policy
+	Namespaces map[string]MTLSMode `json:"namespaces,omitempty"`
+}
+
 // IstioStatus defines the observed state of Istio
 type IstioStatus struct {
-	Status         ConfigState `json:"Status,omitempty"`
-	GatewayAddress []string    `json:"GatewayAddress,omitempty"`
-	ErrorMessage   string      `json:"ErrorMessage,omitempty"`
+	Status ConfigState `json:"Status,omitempty"`
+	// Version of the rolled out control plane
+	Version        IstioVersion `json:"version,omitempty"`
+	GatewayAddress []string     `json:"GatewayAddress,omitempty"`
+	ErrorMessage   string       `json:"ErrorMessage,omitempty"`
+	// Endpoints the gateway is reachable at from outside of its cluster
+	GatewayEndpoints []GatewayEndpoint `json:"gatewayEndpoints,omitempty"`
+	// Generation of the resource the status was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions of the components of the resource
+	Conditions []Condition `json:"conditions,omitempty"`
+	// mTLS modes in effect once the authentication policies are applied
+	MTLS MTLSStatus `json:"mtls,omitempty"`
+	// Changes the reconciliation would make, recorded instead of applied while the dry-run annotation is set
+	PendingChanges []ResourceChange `json:"pendingChanges,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -445,8 +523,10 @@ type IstioStatus struct {
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.Status",description="Status of the resource"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Version of the rolled out control plane"
 // +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.ErrorMessage",description="Error message"
 // +kubebuilder:printcolumn:name="Ingress IPs",type="string",JSONPath=".status.GatewayAddress",description="Ingress gateway addresses of the resource"
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.observedGeneration",description="Generation of the resource the status was computed for",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type Istio struct {
 	metav1.TypeMeta   `json:",inline"`
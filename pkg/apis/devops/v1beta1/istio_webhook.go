@@ -0,0 +1,199 @@
+package v1beta1
+
+import (
+	"regexp"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+// +kubebuilder:webhook:path=/mutate-devops-symcn-com-v1beta1-istio,mutating=true,failurePolicy=fail,groups=devops.symcn.com,resources=istios,verbs=create;update,versions=v1beta1,name=mistio.devops.symcn.com
+// +kubebuilder:webhook:path=/validate-devops-symcn-com-v1beta1-istio,mutating=false,failurePolicy=fail,groups=devops.symcn.com,resources=istios,verbs=create;update,versions=v1beta1,name=vistio.devops.symcn.com
+
+var _ webhook.Defaulter = &Istio{}
+var _ webhook.Validator = &Istio{}
+
+// Default implements webhook.Defaulter. The defaults derived from the Istio version are not persisted,
+// they are still set by the reconcile loop so that changing the version rolls out the images of the new one.
+func (in *Istio) Default() {
+	original := in.DeepCopy()
+	SetDefaults(in)
+
+	if original.Spec.Pilot.Image == nil {
+		in.Spec.Pilot.Image = nil
+	}
+	if original.Spec.SidecarInjector.Image == nil {
+		in.Spec.SidecarInjector.Image = nil
+	}
+	if original.Spec.SidecarInjector.InitCNIConfiguration.Image == "" {
+		in.Spec.SidecarInjector.InitCNIConfiguration.Image = ""
+	}
+	if original.Spec.Proxy.Image == "" {
+		in.Spec.Proxy.Image = ""
+	}
+	if original.Spec.ProxyInit.Image == "" {
+		in.Spec.ProxyInit.Image = ""
+	}
+	for i, revision := range original.Spec.Istiod.Revisions {
+		if revision.Version == "" {
+			in.Spec.Istiod.Revisions[i].Version = ""
+		}
+		if revision.Image == nil {
+			in.Spec.Istiod.Revisions[i].Image = nil
+		}
+		if revision.ProxyImage == "" {
+			in.Spec.Istiod.Revisions[i].ProxyImage = ""
+		}
+	}
+}
+
+// ValidateCreate implements webhook.Validator
+func (in *Istio) ValidateCreate() error {
+	return in.validate(nil)
+}
+
+// ValidateUpdate implements webhook.Validator
+func (in *Istio) ValidateUpdate(old runtime.Object) error {
+	return in.validate(old.(*Istio))
+}
+
+// ValidateDelete implements webhook.Validator
+func (in *Istio) ValidateDelete() error {
+	return nil
+}
+
+func (in *Istio) validate(old *Istio) error {
+	// the finalizer must be removable whatever the spec is
+	if !in.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+
+	var from IstioVersion
+	if old != nil {
+		from = old.Status.Version
+	}
+	if err := from.ValidateUpgrade(in.Spec.Version); err != nil {
+		errs = append(errs, field.Invalid(spec.Child("version"), in.Spec.Version, err.Error()))
+	}
+	if err := in.Spec.Istiod.ValidateRevisions(); err != nil {
+		errs = append(errs, field.Invalid(spec.Child("istiod", "revisions"), in.Spec.Istiod.Revisions, err.Error()))
+	}
+	if err := in.Spec.MeshPolicy.ValidateNamespaces(in.Namespace); err != nil {
+		errs = append(errs, field.Invalid(spec.Child("meshPolicy", "namespaces"), in.Spec.MeshPolicy.Namespaces, err.Error()))
+	}
+
+	errs = append(errs, validateIPRanges(in.Spec.IncludeIPRanges, spec.Child("includeIPRanges"))...)
+	errs = append(errs, validateIPRanges(in.Spec.ExcludeIPRanges, spec.Child("excludeIPRanges"))...)
+	if in.Spec.AutoInjectionNamespaceSelector != nil {
+		errs = append(errs, metav1validation.ValidateLabelSelector(in.Spec.AutoInjectionNamespaceSelector, spec.Child("autoInjectionNamespaceSelector"))...)
+	}
+	errs = append(errs, validateLocalityLB(in.Spec.LocalityLB, spec.Child("localityLB"))...)
+	errs = append(errs, validateTracer(in.Spec.Tracing.Tracer, spec.Child("tracing", "tracer"))...)
+	errs = append(errs, validatePrometheusStorage(in.Spec.Prometheus.Storage, spec.Child("prometheus", "storage"))...)
+	errs = append(errs, validatePrometheusURL(in.Spec.Prometheus, spec.Child("prometheus"))...)
+	errs = append(errs, validateKialiNamespaces(in.Spec.Kiali.AccessibleNamespaces, spec.Child("kiali", "accessibleNamespaces"))...)
+	errs = append(errs, validateReplicas(in.Spec.Pilot.MinReplicas, in.Spec.Pilot.MaxReplicas, spec.Child("pilot"))...)
+	errs = append(errs, validateReplicas(in.Spec.Gateways.IngressConfig.MinReplicas, in.Spec.Gateways.IngressConfig.MaxReplicas, spec.Child("gateways", "ingress"))...)
+	errs = append(errs, validateReplicas(in.Spec.Gateways.EgressConfig.MinReplicas, in.Spec.Gateways.EgressConfig.MaxReplicas, spec.Child("gateways", "egress"))...)
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("Istio").GroupKind(), in.Name, errs)
+}
+
+func validateLocalityLB(localityLB *LocalityLBConfiguration, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if localityLB == nil {
+		return errs
+	}
+
+	if len(localityLB.Distribute) > 0 && len(localityLB.Failover) > 0 {
+		errs = append(errs, field.Forbidden(path.Child("failover"), "only one of distribute or failover can be set"))
+	}
+
+	for i, distribute := range localityLB.Distribute {
+		if distribute == nil {
+			continue
+		}
+		var sum uint32
+		for _, weight := range distribute.To {
+			sum += weight
+		}
+		if sum != 100 {
+			errs = append(errs, field.Invalid(path.Child("distribute").Index(i).Child("to"), int(sum), "the sum of the weights must be 100"))
+		}
+	}
+
+	return errs
+}
+
+func validateTracer(tracer TracerType, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	switch tracer {
+	case "", TracerTypeZipkin, TracerTypeLightstep, TracerTypeDatadog, TracerTypeStackdriver:
+	default:
+		errs = append(errs, field.NotSupported(path, tracer, []string{
+			string(TracerTypeZipkin),
+			string(TracerTypeLightstep),
+			string(TracerTypeDatadog),
+			string(TracerTypeStackdriver),
+		}))
+	}
+
+	return errs
+}
+
+func validatePrometheusStorage(storage *PrometheusStorageConfiguration, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if storage == nil {
+		return errs
+	}
+
+	size, err := resource.ParseQuantity(storage.Size)
+	if err != nil {
+		errs = append(errs, field.Invalid(path.Child("size"), storage.Size, err.Error()))
+	} else if size.Sign() <= 0 {
+		errs = append(errs, field.Invalid(path.Child("size"), storage.Size, "must be greater than zero"))
+	}
+
+	return errs
+}
+
+// validatePrometheusURL requires the address of the Prometheus when none is installed for the addons
+func validatePrometheusURL(prometheus PrometheusConfiguration, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if !utils.PointerToBool(prometheus.Enabled) || !utils.PointerToBool(prometheus.PrometheusOperator) {
+		return errs
+	}
+
+	if prometheus.URL == "" {
+		errs = append(errs, field.Required(path.Child("url"), "must be set when the metrics are collected by a prometheus-operator"))
+	}
+
+	return errs
+}
+
+func validateKialiNamespaces(namespaces []string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, namespace := range namespaces {
+		if namespace == "**" {
+			continue
+		}
+		if _, err := regexp.Compile(namespace); err != nil {
+			errs = append(errs, field.Invalid(path.Index(i), namespace, err.Error()))
+		}
+	}
+
+	return errs
+}
@@ -18,6 +18,16 @@ type MeshGatewaySpec struct {
 	// +kubebuilder:validation:MinItems=1
 	Ports []corev1.ServicePort `json:"ports"`
 	Type  GatewayType          `json:"type"`
+	// IstioControlPlane the gateway belongs to, the Istio of its namespace
+	// or else the default control plane of the cluster when not set
+	IstioControlPlane *IstioControlPlaneReference `json:"istioControlPlane,omitempty"`
+}
+
+// IstioControlPlaneReference references the Istio resource of a control plane
+type IstioControlPlaneReference struct {
+	Name string `json:"name"`
+	// Namespace of the Istio resource, the one of the referencing resource when empty
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // MeshGatewayStatus defines the observed state of MeshGateway
@@ -25,6 +35,16 @@ type MeshGatewayStatus struct {
 	Status         ConfigState `json:"Status,omitempty"`
 	GatewayAddress []string    `json:"GatewayAddress,omitempty"`
 	ErrorMessage   string      `json:"ErrorMessage,omitempty"`
+	// Endpoints the gateway is reachable at from outside of its cluster
+	GatewayEndpoints []GatewayEndpoint `json:"gatewayEndpoints,omitempty"`
+	// Version of the control plane the rolled out gateway belongs to
+	Version IstioVersion `json:"version,omitempty"`
+	// IstioControlPlane the gateway was reconciled with
+	IstioControlPlane *IstioControlPlaneReference `json:"istioControlPlane,omitempty"`
+	// Generation of the resource the status was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions of the components of the resource
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -35,8 +55,10 @@ type MeshGatewayStatus struct {
 // +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of the gateway"
 // +kubebuilder:printcolumn:name="Service Type",type="string",JSONPath=".spec.serviceType",description="Type of the service"
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.Status",description="Status of the resource"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Version of the control plane the rolled out gateway belongs to"
 // +kubebuilder:printcolumn:name="Ingress IPs",type="string",JSONPath=".status.GatewayAddress",description="Ingress gateway addresses of the resource"
 // +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.ErrorMessage",description="Error message"
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.observedGeneration",description="Generation of the resource the status was computed for",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:resource:path=meshgateways,shortName=mgw
 type MeshGateway struct {
@@ -0,0 +1,60 @@
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/mutate-devops-symcn-com-v1beta1-meshgateway,mutating=true,failurePolicy=fail,groups=devops.symcn.com,resources=meshgateways,verbs=create;update,versions=v1beta1,name=mmeshgateway.devops.symcn.com
+// +kubebuilder:webhook:path=/validate-devops-symcn-com-v1beta1-meshgateway,mutating=false,failurePolicy=fail,groups=devops.symcn.com,resources=meshgateways,verbs=create;update,versions=v1beta1,name=vmeshgateway.devops.symcn.com
+
+var _ webhook.Defaulter = &MeshGateway{}
+var _ webhook.Validator = &MeshGateway{}
+
+// Default implements webhook.Defaulter
+func (in *MeshGateway) Default() {
+	in.SetDefaults()
+}
+
+// ValidateCreate implements webhook.Validator
+func (in *MeshGateway) ValidateCreate() error {
+	return in.validate()
+}
+
+// ValidateUpdate implements webhook.Validator
+func (in *MeshGateway) ValidateUpdate(old runtime.Object) error {
+	return in.validate()
+}
+
+// ValidateDelete implements webhook.Validator
+func (in *MeshGateway) ValidateDelete() error {
+	return nil
+}
+
+func (in *MeshGateway) validate() error {
+	// the finalizer must be removable whatever the spec is
+	if !in.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+
+	switch in.Spec.Type {
+	case GatewayTypeIngress, GatewayTypeEgress:
+	default:
+		errs = append(errs, field.NotSupported(spec.Child("type"), in.Spec.Type, []string{
+			string(GatewayTypeIngress),
+			string(GatewayTypeEgress),
+		}))
+	}
+	errs = append(errs, validateReplicas(in.Spec.MinReplicas, in.Spec.MaxReplicas, spec)...)
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("MeshGateway").GroupKind(), in.Name, errs)
+}
@@ -0,0 +1,50 @@
+package v1beta1
+
+import (
+	"github.com/pkg/errors"
+)
+
+// PeerAuthenticationMode returns the mode of the PeerAuthentication resource matching the mTLS mode
+func (m MTLSMode) PeerAuthenticationMode() string {
+	if m == DISABLED {
+		return "DISABLE"
+	}
+
+	return string(m)
+}
+
+// ValidateNamespaces checks whether the namespace overrides can be applied next to the mesh-wide policy,
+// the mesh-wide policy lives in the root namespace which therefore cannot be overridden
+func (c MeshPolicyConfiguration) ValidateNamespaces(rootNamespace string) error {
+	namespaces := make(map[string]bool)
+	for _, override := range c.Namespaces {
+		if override.Namespace == rootNamespace {
+			return errors.Errorf("the mTLS mode of the root namespace '%s' is set by the mesh-wide policy", rootNamespace)
+		}
+		if namespaces[override.Namespace] {
+			return errors.Errorf("the mTLS mode of namespace '%s' is overridden more than once", override.Namespace)
+		}
+		namespaces[override.Namespace] = true
+	}
+
+	return nil
+}
+
+// MTLSStatus returns the mTLS modes in effect once the mesh-wide policy and the overrides of the given namespaces
+// are applied
+func (c MeshPolicyConfiguration) MTLSStatus(applied map[string]bool) MTLSStatus {
+	status := MTLSStatus{
+		Mode: c.MTLSMode,
+	}
+	for _, override := range c.Namespaces {
+		if !applied[override.Namespace] {
+			continue
+		}
+		if status.Namespaces == nil {
+			status.Namespaces = make(map[string]MTLSMode)
+		}
+		status.Namespaces[override.Namespace] = override.MTLSMode
+	}
+
+	return status
+}
@@ -0,0 +1,45 @@
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestValidateNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces []NamespaceMTLSConfiguration
+		wantErr    bool
+	}{
+		{name: "no override"},
+		{
+			name: "distinct namespaces",
+			namespaces: []NamespaceMTLSConfiguration{
+				{Namespace: "foo", MTLSMode: PERMISSIVE},
+				{Namespace: "bar", MTLSMode: DISABLED},
+			},
+		},
+		{
+			name:       "root namespace",
+			namespaces: []NamespaceMTLSConfiguration{{Namespace: "istio-system", MTLSMode: PERMISSIVE}},
+			wantErr:    true,
+		},
+		{
+			name: "namespace overridden twice",
+			namespaces: []NamespaceMTLSConfiguration{
+				{Namespace: "foo", MTLSMode: PERMISSIVE},
+				{Namespace: "foo", MTLSMode: STRICT},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := MeshPolicyConfiguration{MTLSMode: STRICT, Namespaces: tt.namespaces}
+			err := c.ValidateNamespaces("istio-system")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
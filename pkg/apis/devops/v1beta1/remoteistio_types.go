@@ -33,6 +33,9 @@ type RemoteIstioSpec struct {
 	// List of namespaces to label with sidecar auto injection enabled
 	AutoInjectionNamespaces []string `json:"autoInjectionNamespaces,omitempty"`
 
+	// Namespaces matching the selector are labeled with sidecar auto injection enabled as well
+	AutoInjectionNamespaceSelector *metav1.LabelSelector `json:"autoInjectionNamespaceSelector,omitempty"`
+
 	// DefaultResources are applied for all Istio components by default, can be overridden for each component
 	DefaultResources *corev1.ResourceRequirements `json:"defaultResources,omitempty"`
 
@@ -53,6 +56,14 @@ type RemoteIstioStatus struct {
 	Status         ConfigState `json:"Status,omitempty"`
 	GatewayAddress []string    `json:"GatewayAddress,omitempty"`
 	ErrorMessage   string      `json:"ErrorMessage,omitempty"`
+	// Endpoints the gateway is reachable at from outside of its cluster
+	GatewayEndpoints []GatewayEndpoint `json:"gatewayEndpoints,omitempty"`
+	// Version of the control plane rolled out to the remote cluster
+	Version IstioVersion `json:"version,omitempty"`
+	// Generation of the resource the status was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions of the components of the resource
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -61,8 +72,10 @@ type RemoteIstioStatus struct {
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.Status",description="Status of the resource"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Version of the control plane rolled out to the remote cluster"
 // +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.ErrorMessage",description="Error message"
 // +kubebuilder:printcolumn:name="Ingress IPs",type="string",JSONPath=".status.GatewayAddress",description="Ingress gateway addresses of the resource"
+// +kubebuilder:printcolumn:name="Observed Generation",type="integer",JSONPath=".status.observedGeneration",description="Generation of the resource the status was computed for",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type RemoteIstio struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -0,0 +1,56 @@
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// +kubebuilder:webhook:path=/mutate-devops-symcn-com-v1beta1-remoteistio,mutating=true,failurePolicy=fail,groups=devops.symcn.com,resources=remoteistios,verbs=create;update,versions=v1beta1,name=mremoteistio.devops.symcn.com
+// +kubebuilder:webhook:path=/validate-devops-symcn-com-v1beta1-remoteistio,mutating=false,failurePolicy=fail,groups=devops.symcn.com,resources=remoteistios,verbs=create;update,versions=v1beta1,name=vremoteistio.devops.symcn.com
+
+var _ webhook.Defaulter = &RemoteIstio{}
+var _ webhook.Validator = &RemoteIstio{}
+
+// Default implements webhook.Defaulter
+func (in *RemoteIstio) Default() {
+	SetRemoteIstioDefaults(in)
+}
+
+// ValidateCreate implements webhook.Validator
+func (in *RemoteIstio) ValidateCreate() error {
+	return in.validate()
+}
+
+// ValidateUpdate implements webhook.Validator
+func (in *RemoteIstio) ValidateUpdate(old runtime.Object) error {
+	return in.validate()
+}
+
+// ValidateDelete implements webhook.Validator
+func (in *RemoteIstio) ValidateDelete() error {
+	return nil
+}
+
+func (in *RemoteIstio) validate() error {
+	if !in.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	var errs field.ErrorList
+	spec := field.NewPath("spec")
+
+	errs = append(errs, validateIPRanges(in.Spec.IncludeIPRanges, spec.Child("includeIPRanges"))...)
+	errs = append(errs, validateIPRanges(in.Spec.ExcludeIPRanges, spec.Child("excludeIPRanges"))...)
+	if in.Spec.AutoInjectionNamespaceSelector != nil {
+		errs = append(errs, metav1validation.ValidateLabelSelector(in.Spec.AutoInjectionNamespaceSelector, spec.Child("autoInjectionNamespaceSelector"))...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("RemoteIstio").GroupKind(), in.Name, errs)
+}
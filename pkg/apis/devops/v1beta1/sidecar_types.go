@@ -17,16 +17,57 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// SidecarPort describes a port the sidecar listens on or forwards to
+type SidecarPort struct {
+	// Port number
+	Number int32 `json:"number"`
+	// Protocol of the port, one of HTTP|HTTPS|GRPC|HTTP2|MONGO|TCP|TLS
+	Protocol string `json:"protocol,omitempty"`
+	// Name of the port
+	Name string `json:"name,omitempty"`
+	// DefaultEndpoint is the loopback address or unix socket inbound traffic is forwarded to,
+	// only used for inbound ports
+	DefaultEndpoint string `json:"defaultEndpoint,omitempty"`
+}
+
 // SidecarSpec defines the desired state of Sidecar
 type SidecarSpec struct {
-	Foo string `json:"foo,omitempty"`
+	// WorkloadSelector selects the pods of the namespace the settings apply to,
+	// all workloads of the namespace are selected if empty
+	WorkloadSelector map[string]string `json:"workloadSelector,omitempty"`
+
+	// Resources of the injected istio-proxy container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Concurrency is the number of worker threads of the proxy, 0 means one per core
+	Concurrency *int32 `json:"concurrency,omitempty"`
+
+	// LogLevel of the proxy
+	// +kubebuilder:validation:Enum=trace;debug;info;warning;error;critical;off
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// EgressHosts the workloads are allowed to reach in namespace/dnsName format, e.g. "./*" or "istio-system/*"
+	EgressHosts []string `json:"egressHosts,omitempty"`
+
+	// InboundPorts the sidecar intercepts traffic on
+	InboundPorts []SidecarPort `json:"inboundPorts,omitempty"`
+
+	// OutboundPorts the egress hosts are reachable on, all ports are allowed if empty
+	OutboundPorts []SidecarPort `json:"outboundPorts,omitempty"`
 }
 
 // SidecarStatus defines the observed state of Sidecar
 type SidecarStatus struct {
+	Status       ConfigState `json:"Status,omitempty"`
+	ErrorMessage string      `json:"ErrorMessage,omitempty"`
+	// Workloads the settings are applied to
+	Workloads []string `json:"workloads,omitempty"`
+	// Workloads selected by the policy but left alone, the settings of another Sidecar are applied to them
+	ConflictingWorkloads []string `json:"conflictingWorkloads,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -35,6 +76,8 @@ type SidecarStatus struct {
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName=sd
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.Status",description="Status of the resource"
+// +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.ErrorMessage",description="Error message"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. "
 type Sidecar struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -0,0 +1,129 @@
+package v1beta1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/pkg/errors"
+)
+
+// supportedIstioVersions maps the supported Istio minor versions to the image tag used by default for them
+var supportedIstioVersions = map[string]string{
+	"1.5": "1.5.2",
+	"1.6": "1.6.0",
+}
+
+// SupportedIstioMinorVersions returns the supported Istio minor versions in ascending order
+func SupportedIstioMinorVersions() []string {
+	versions := make([]string, 0, len(supportedIstioVersions))
+	for v := range supportedIstioVersions {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return semver.New(versions[i] + ".0").LessThan(*semver.New(versions[j] + ".0"))
+	})
+
+	return versions
+}
+
+// semver returns the version in major.minor.patch form, a missing patch version is taken from the default image tag
+func (v IstioVersion) semver() (*semver.Version, error) {
+	s := strings.TrimPrefix(string(v), "v")
+	if strings.Count(s, ".") == 1 {
+		if tag, ok := supportedIstioVersions[s]; ok {
+			s = tag
+		} else {
+			s += ".0"
+		}
+	}
+
+	version, err := semver.NewVersion(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid Istio version '%s'", v)
+	}
+
+	return version, nil
+}
+
+// MinorVersion returns the major.minor part of the version
+func (v IstioVersion) MinorVersion() string {
+	version, err := v.semver()
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d.%d", version.Major, version.Minor)
+}
+
+// IsSupported returns whether the operator is able to install the version
+func (v IstioVersion) IsSupported() bool {
+	_, ok := supportedIstioVersions[v.MinorVersion()]
+	return ok
+}
+
+// ImageTag returns the tag of the Istio images of the version
+func (v IstioVersion) ImageTag() string {
+	version, err := v.semver()
+	if err != nil {
+		return supportedIstioVersions[defaultIstioMinorVersion]
+	}
+
+	return version.String()
+}
+
+// ProxyVersionRegex returns the regular expression matching the proxies of the minor version
+func (v IstioVersion) ProxyVersionRegex() string {
+	return "^" + strings.Replace(v.MinorVersion(), ".", `\.`, -1) + ".*"
+}
+
+// ValidateUpgrade checks whether the control plane can be upgraded from the version to the target version,
+// patch versions can be changed freely, minor versions can only be upgraded one at a time
+func (v IstioVersion) ValidateUpgrade(target IstioVersion) error {
+	if !target.IsSupported() {
+		return errors.Errorf("Istio version '%s' is not supported, supported versions: %s", target, strings.Join(SupportedIstioMinorVersions(), ", "))
+	}
+	if v == "" || v == target {
+		return nil
+	}
+
+	from, err := v.semver()
+	if err != nil {
+		return err
+	}
+	to, err := target.semver()
+	if err != nil {
+		return err
+	}
+
+	if from.Major != to.Major {
+		return errors.Errorf("upgrading Istio from '%s' to '%s' is not supported: major version change", v, target)
+	}
+
+	switch minorDiff := to.Minor - from.Minor; {
+	case minorDiff == 0, minorDiff == 1:
+		return nil
+	case minorDiff < 0:
+		return errors.Errorf("downgrading Istio from '%s' to '%s' is not supported", v, target)
+	default:
+		return errors.Errorf("upgrading Istio from '%s' to '%s' is not supported: upgrade to %d.%d first", v, target, from.Major, from.Minor+1)
+	}
+}
+
+// ValidateRevisions checks whether the istiod revisions can be run side by side with the default control plane
+func (c IstiodConfiguration) ValidateRevisions() error {
+	names := make(map[string]bool)
+	for _, revision := range c.Revisions {
+		if names[revision.Name] {
+			return errors.Errorf("istiod revision '%s' is defined more than once", revision.Name)
+		}
+		names[revision.Name] = true
+
+		if !revision.Version.IsSupported() {
+			return errors.Errorf("Istio version '%s' of istiod revision '%s' is not supported, supported versions: %s", revision.Version, revision.Name, strings.Join(SupportedIstioMinorVersions(), ", "))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package v1beta1
+
+import (
+	"testing"
+)
+
+func TestValidateUpgrade(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    IstioVersion
+		to      IstioVersion
+		wantErr bool
+	}{
+		{name: "fresh install", from: "", to: "1.6"},
+		{name: "same version", from: "1.5", to: "1.5"},
+		{name: "patch upgrade", from: "1.5.2", to: "1.5.4"},
+		{name: "patch downgrade", from: "1.5.4", to: "1.5.2"},
+		{name: "minor upgrade", from: "1.5", to: "1.6.0"},
+		{name: "minor downgrade", from: "1.6", to: "1.5", wantErr: true},
+		{name: "unsupported target", from: "1.6", to: "1.7", wantErr: true},
+		{name: "unsupported fresh install", from: "", to: "1.4", wantErr: true},
+		{name: "skipped minor version", from: "1.4.6", to: "1.6", wantErr: true},
+		{name: "major version change", from: "0.8", to: "1.6", wantErr: true},
+		{name: "invalid current version", from: "latest", to: "1.6", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.from.ValidateUpgrade(tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("upgrade from %q to %q: got error %v, want error %t", tt.from, tt.to, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRevisions(t *testing.T) {
+	tests := []struct {
+		name      string
+		revisions []IstiodRevision
+		wantErr   bool
+	}{
+		{name: "no revision"},
+		{
+			name: "supported revisions",
+			revisions: []IstiodRevision{
+				{Name: "canary", Version: "1.6"},
+				{Name: "stable", Version: "1.5.2"},
+			},
+		},
+		{
+			name: "duplicate revision",
+			revisions: []IstiodRevision{
+				{Name: "canary", Version: "1.6"},
+				{Name: "canary", Version: "1.5"},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "unsupported version",
+			revisions: []IstiodRevision{{Name: "canary", Version: "1.7"}},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := IstiodConfiguration{Revisions: tt.revisions}.ValidateRevisions()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
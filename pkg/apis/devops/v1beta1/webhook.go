@@ -0,0 +1,52 @@
+package v1beta1
+
+import (
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupWebhooksWithManager registers the defaulting and validating webhooks of the devops.symcn.com kinds
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	for _, t := range []runtime.Object{
+		&Istio{},
+		&MeshGateway{},
+		&RemoteIstio{},
+	} {
+		err := ctrl.NewWebhookManagedBy(mgr).For(t).Complete()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateIPRanges checks a comma separated list of CIDRs, '*' stands for every address
+func validateIPRanges(ranges string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if ranges == "" || ranges == "*" {
+		return errs
+	}
+
+	for _, cidr := range strings.Split(ranges, ",") {
+		if _, _, err := net.ParseCIDR(strings.TrimSpace(cidr)); err != nil {
+			errs = append(errs, field.Invalid(path, ranges, "must be '*' or a comma separated list of CIDRs: "+err.Error()))
+		}
+	}
+
+	return errs
+}
+
+// validateReplicas checks that the autoscaling bounds are consistent
+func validateReplicas(minReplicas, maxReplicas *int32, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if minReplicas != nil && maxReplicas != nil && *minReplicas > *maxReplicas {
+		errs = append(errs, field.Invalid(path.Child("minReplicas"), *minReplicas, "must not be greater than maxReplicas"))
+	}
+
+	return errs
+}
@@ -251,6 +251,22 @@ func (in *CertificateConfig) DeepCopy() *CertificateConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogConfiugration) DeepCopyInto(out *DatadogConfiugration) {
 	*out = *in
@@ -342,6 +358,41 @@ func (in *GatewayConfiguration) DeepCopy() *GatewayConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayEndpoint) DeepCopyInto(out *GatewayEndpoint) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]GatewayEndpointPort, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayEndpoint.
+func (in *GatewayEndpoint) DeepCopy() *GatewayEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayEndpointPort) DeepCopyInto(out *GatewayEndpointPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayEndpointPort.
+func (in *GatewayEndpointPort) DeepCopy() *GatewayEndpointPort {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayEndpointPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewaySDSConfiguration) DeepCopyInto(out *GatewaySDSConfiguration) {
 	*out = *in
@@ -378,6 +429,11 @@ func (in *GatewaysConfiguration) DeepCopyInto(out *GatewaysConfiguration) {
 	in.IngressConfig.DeepCopyInto(&out.IngressConfig)
 	in.EgressConfig.DeepCopyInto(&out.EgressConfig)
 	in.K8sIngress.DeepCopyInto(&out.K8sIngress)
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewaysConfiguration.
@@ -390,6 +446,27 @@ func (in *GatewaysConfiguration) DeepCopy() *GatewaysConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaConfiguration) DeepCopyInto(out *GrafanaConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.BaseK8sResourceConfigurationWithImage.DeepCopyInto(&out.BaseK8sResourceConfigurationWithImage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaConfiguration.
+func (in *GrafanaConfiguration) DeepCopy() *GrafanaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitCNIConfiguration) DeepCopyInto(out *InitCNIConfiguration) {
 	*out = *in
@@ -453,6 +530,21 @@ func (in *Istio) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioControlPlaneReference) DeepCopyInto(out *IstioControlPlaneReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioControlPlaneReference.
+func (in *IstioControlPlaneReference) DeepCopy() *IstioControlPlaneReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioControlPlaneReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioCoreDNS) DeepCopyInto(out *IstioCoreDNS) {
 	*out = *in
@@ -535,7 +627,7 @@ func (in *IstioService) DeepCopy() *IstioService {
 func (in *IstioSpec) DeepCopyInto(out *IstioSpec) {
 	*out = *in
 	in.Logging.DeepCopyInto(&out.Logging)
-	out.MeshPolicy = in.MeshPolicy
+	in.MeshPolicy.DeepCopyInto(&out.MeshPolicy)
 	if in.AutoMTLS != nil {
 		in, out := &in.AutoMTLS, &out.AutoMTLS
 		*out = new(bool)
@@ -546,6 +638,11 @@ func (in *IstioSpec) DeepCopyInto(out *IstioSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AutoInjectionNamespaceSelector != nil {
+		in, out := &in.AutoInjectionNamespaceSelector, &out.AutoInjectionNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MountMtlsCerts != nil {
 		in, out := &in.MountMtlsCerts, &out.MountMtlsCerts
 		*out = new(bool)
@@ -582,6 +679,9 @@ func (in *IstioSpec) DeepCopyInto(out *IstioSpec) {
 		**out = **in
 	}
 	in.IstioCoreDNS.DeepCopyInto(&out.IstioCoreDNS)
+	in.Prometheus.DeepCopyInto(&out.Prometheus)
+	in.Grafana.DeepCopyInto(&out.Grafana)
+	in.Kiali.DeepCopyInto(&out.Kiali)
 	if in.LocalityLB != nil {
 		in, out := &in.LocalityLB, &out.LocalityLB
 		*out = new(LocalityLBConfiguration)
@@ -619,6 +719,26 @@ func (in *IstioStatus) DeepCopyInto(out *IstioStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.GatewayEndpoints != nil {
+		in, out := &in.GatewayEndpoints, &out.GatewayEndpoints
+		*out = make([]GatewayEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.MTLS.DeepCopyInto(&out.MTLS)
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = make([]ResourceChange, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioStatus.
@@ -644,6 +764,13 @@ func (in *IstiodConfiguration) DeepCopyInto(out *IstiodConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]IstiodRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstiodConfiguration.
@@ -656,6 +783,31 @@ func (in *IstiodConfiguration) DeepCopy() *IstiodConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstiodRevision) DeepCopyInto(out *IstiodRevision) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicaCount != nil {
+		in, out := &in.ReplicaCount, &out.ReplicaCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstiodRevision.
+func (in *IstiodRevision) DeepCopy() *IstiodRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(IstiodRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8sIngressConfiguration) DeepCopyInto(out *K8sIngressConfiguration) {
 	*out = *in
@@ -681,6 +833,32 @@ func (in *K8sIngressConfiguration) DeepCopy() *K8sIngressConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KialiConfiguration) DeepCopyInto(out *KialiConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.BaseK8sResourceConfigurationWithImage.DeepCopyInto(&out.BaseK8sResourceConfigurationWithImage)
+	if in.AccessibleNamespaces != nil {
+		in, out := &in.AccessibleNamespaces, &out.AccessibleNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KialiConfiguration.
+func (in *KialiConfiguration) DeepCopy() *KialiConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KialiConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LightstepConfiguration) DeepCopyInto(out *LightstepConfiguration) {
 	*out = *in
@@ -795,6 +973,28 @@ func (in *LoggingConfiguration) DeepCopy() *LoggingConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MTLSStatus) DeepCopyInto(out *MTLSStatus) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make(map[string]MTLSMode, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MTLSStatus.
+func (in *MTLSStatus) DeepCopy() *MTLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MTLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshGateway) DeepCopyInto(out *MeshGateway) {
 	*out = *in
@@ -907,6 +1107,11 @@ func (in *MeshGatewaySpec) DeepCopyInto(out *MeshGatewaySpec) {
 		*out = make([]v1.ServicePort, len(*in))
 		copy(*out, *in)
 	}
+	if in.IstioControlPlane != nil {
+		in, out := &in.IstioControlPlane, &out.IstioControlPlane
+		*out = new(IstioControlPlaneReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewaySpec.
@@ -927,6 +1132,25 @@ func (in *MeshGatewayStatus) DeepCopyInto(out *MeshGatewayStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.GatewayEndpoints != nil {
+		in, out := &in.GatewayEndpoints, &out.GatewayEndpoints
+		*out = make([]GatewayEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IstioControlPlane != nil {
+		in, out := &in.IstioControlPlane, &out.IstioControlPlane
+		*out = new(IstioControlPlaneReference)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshGatewayStatus.
@@ -942,6 +1166,11 @@ func (in *MeshGatewayStatus) DeepCopy() *MeshGatewayStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshPolicyConfiguration) DeepCopyInto(out *MeshPolicyConfiguration) {
 	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceMTLSConfiguration, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshPolicyConfiguration.
@@ -954,6 +1183,21 @@ func (in *MeshPolicyConfiguration) DeepCopy() *MeshPolicyConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceMTLSConfiguration) DeepCopyInto(out *NamespaceMTLSConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceMTLSConfiguration.
+func (in *NamespaceMTLSConfiguration) DeepCopy() *NamespaceMTLSConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceMTLSConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutboundTrafficPolicyConfiguration) DeepCopyInto(out *OutboundTrafficPolicyConfiguration) {
 	*out = *in
@@ -1037,6 +1281,57 @@ func (in *PilotConfiguration) DeepCopy() *PilotConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusConfiguration) DeepCopyInto(out *PrometheusConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	in.BaseK8sResourceConfigurationWithImage.DeepCopyInto(&out.BaseK8sResourceConfigurationWithImage)
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(PrometheusStorageConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusOperator != nil {
+		in, out := &in.PrometheusOperator, &out.PrometheusOperator
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusConfiguration.
+func (in *PrometheusConfiguration) DeepCopy() *PrometheusConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusStorageConfiguration) DeepCopyInto(out *PrometheusStorageConfiguration) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusStorageConfiguration.
+func (in *PrometheusStorageConfiguration) DeepCopy() *PrometheusStorageConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusStorageConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyConfiguration) DeepCopyInto(out *ProxyConfiguration) {
 	*out = *in
@@ -1200,6 +1495,11 @@ func (in *RemoteIstioSpec) DeepCopyInto(out *RemoteIstioSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AutoInjectionNamespaceSelector != nil {
+		in, out := &in.AutoInjectionNamespaceSelector, &out.AutoInjectionNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DefaultResources != nil {
 		in, out := &in.DefaultResources, &out.DefaultResources
 		*out = new(v1.ResourceRequirements)
@@ -1229,6 +1529,20 @@ func (in *RemoteIstioStatus) DeepCopyInto(out *RemoteIstioStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.GatewayEndpoints != nil {
+		in, out := &in.GatewayEndpoints, &out.GatewayEndpoints
+		*out = make([]GatewayEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteIstioStatus.
@@ -1241,13 +1555,28 @@ func (in *RemoteIstioStatus) DeepCopy() *RemoteIstioStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceChange) DeepCopyInto(out *ResourceChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceChange.
+func (in *ResourceChange) DeepCopy() *ResourceChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sidecar) DeepCopyInto(out *Sidecar) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sidecar.
@@ -1398,9 +1727,56 @@ func (in *SidecarList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarPort) DeepCopyInto(out *SidecarPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarPort.
+func (in *SidecarPort) DeepCopy() *SidecarPort {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SidecarSpec) DeepCopyInto(out *SidecarSpec) {
 	*out = *in
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EgressHosts != nil {
+		in, out := &in.EgressHosts, &out.EgressHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InboundPorts != nil {
+		in, out := &in.InboundPorts, &out.InboundPorts
+		*out = make([]SidecarPort, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutboundPorts != nil {
+		in, out := &in.OutboundPorts, &out.OutboundPorts
+		*out = make([]SidecarPort, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarSpec.
@@ -1416,6 +1792,16 @@ func (in *SidecarSpec) DeepCopy() *SidecarSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SidecarStatus) DeepCopyInto(out *SidecarStatus) {
 	*out = *in
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConflictingWorkloads != nil {
+		in, out := &in.ConflictingWorkloads, &out.ConflictingWorkloads
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarStatus.
@@ -19,6 +19,7 @@ package controllers
 import (
 	"github.com/symcn/mid-operator/pkg/controllers/istio"
 	"github.com/symcn/mid-operator/pkg/controllers/meshgateway"
+	"github.com/symcn/mid-operator/pkg/controllers/remoteistio"
 	"github.com/symcn/mid-operator/pkg/controllers/sidecar"
 	"github.com/symcn/mid-operator/pkg/option"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -34,8 +35,13 @@ func AddToManager(m manager.Manager, opt *option.ControllersManagerOption) error
 	}
 
 	if opt.EnableIstio {
-		AddToManagerFuncs = append(AddToManagerFuncs, istio.Add)
-		AddToManagerFuncs = append(AddToManagerFuncs, meshgateway.Add)
+		AddToManagerFuncs = append(AddToManagerFuncs, func(m manager.Manager) error {
+			return istio.Add(m, opt.ComponentThreadiness)
+		})
+		AddToManagerFuncs = append(AddToManagerFuncs, func(m manager.Manager) error {
+			return meshgateway.Add(m, opt.ControlPlaneWaitTimeout)
+		})
+		AddToManagerFuncs = append(AddToManagerFuncs, remoteistio.Add)
 	}
 
 	for _, f := range AddToManagerFuncs {
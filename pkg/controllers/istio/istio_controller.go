@@ -21,25 +21,22 @@ import (
 
 	"github.com/go-logr/logr"
 	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
-	"github.com/symcn/mid-operator/pkg/controllers/resources"
-	"github.com/symcn/mid-operator/pkg/controllers/resources/base"
-	"github.com/symcn/mid-operator/pkg/controllers/resources/cni"
-	"github.com/symcn/mid-operator/pkg/controllers/resources/egressgateway"
 	"github.com/symcn/mid-operator/pkg/controllers/resources/ingressgateway"
-	"github.com/symcn/mid-operator/pkg/controllers/resources/istiocoredns"
-	"github.com/symcn/mid-operator/pkg/controllers/resources/istiod"
-	"github.com/symcn/mid-operator/pkg/controllers/resources/proxywasm"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
-	"time"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/goph/emperror"
 	"github.com/pkg/errors"
 
 	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/metrics"
 	"github.com/symcn/mid-operator/pkg/static"
 	"github.com/symcn/mid-operator/pkg/utils"
 	"k8s.io/apimachinery/pkg/types"
@@ -50,6 +47,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const finalizerID = "istio.devops.symcn.com"
+
 // IstioReconciler reconciles a Istio object
 type IstioReconciler struct {
 	client.Client
@@ -59,9 +58,15 @@ type IstioReconciler struct {
 	Scheme         *runtime.Scheme
 	CrdsReconciler *k8sutils.CRDReconciler
 	recorder       record.EventRecorder
+	// threadiness is the number of components of an Istio reconciled at the same time
+	threadiness int
+
+	controller controller.Controller
+	// the Istio networking kinds can only be watched once their CRDs are installed
+	watchedIstioResources map[schema.GroupVersionKind]bool
 }
 
-func Add(mgr manager.Manager) error {
+func Add(mgr manager.Manager, threadiness int) error {
 	dy, err := dynamic.NewForConfig(mgr.GetConfig())
 	if err != nil {
 		return emperror.Wrap(err, "failed to create dynamic client")
@@ -84,23 +89,54 @@ func Add(mgr manager.Manager) error {
 		Scheme:         mgr.GetScheme(),
 		recorder:       mgr.GetEventRecorderFor("istio-controller"),
 		CrdsReconciler: k8sutils.NewCRDReconciler(mgr.GetClient(), crds...),
+		threadiness:    threadiness,
+
+		watchedIstioResources: make(map[schema.GroupVersionKind]bool),
 	}
 
-	errc := reconciler.SetupWithManager(mgr)
-	if errc != nil {
+	err = reconciler.SetupWithManager(mgr)
+	if err != nil {
 		return errors.Wrapf(err, "unable to create Istio controller")
 	}
 	return nil
 }
 
 func (r *IstioReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&devopsv1beta1.Istio{}).
-		Complete(r)
+		Watches(&source.Kind{Type: &devopsv1beta1.RemoteIstio{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.istiosForRemoteIstio),
+		})
+
+	// Watch for changes to resources created by the controller
+	for _, t := range ownedResources {
+		builder = builder.Owns(t)
+	}
+	for _, t := range ownedClusterResources {
+		builder = builder.Watches(&source.Kind{Type: t}, k8sutils.EnqueueRequestForOwnerLabels("Istio"))
+	}
+
+	c, err := builder.Build(r)
+	if err != nil {
+		return err
+	}
+	r.controller = c
+
+	// namespaces are labeled for auto injection as soon as they are created or relabeled
+	err = c.Watch(&source.Kind{Type: &corev1.Namespace{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.istiosForNamespace),
+	}, namespaceLabelsPredicate)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // +kubebuilder:rbac:groups=devops.symcn.com,resources=istios,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=devops.symcn.com,resources=istios/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=devops.symcn.com,resources=remoteistios,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 func (r *IstioReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	_ = context.Background()
@@ -113,6 +149,7 @@ func (r *IstioReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	err := r.Client.Get(ctx, req.NamespacedName, config)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
+			metrics.Forget("Istio", req.Namespace, req.Name)
 			return reconcile.Result{}, nil
 		}
 
@@ -120,6 +157,20 @@ func (r *IstioReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return reconcile.Result{}, err
 	}
 
+	if !config.DeletionTimestamp.IsZero() {
+		return r.finalize(config, logger)
+	}
+
+	if !utils.ContainsString(config.Finalizers, finalizerID) {
+		typeMeta := config.TypeMeta
+		config.Finalizers = append(config.Finalizers, finalizerID)
+		err = r.Client.Update(ctx, config)
+		if err != nil {
+			return reconcile.Result{}, emperror.Wrap(err, "could not add finalizer")
+		}
+		config.TypeMeta = typeMeta
+	}
+
 	// Set default values where not set
 	devopsv1beta1.SetDefaults(config)
 
@@ -130,41 +181,88 @@ func (r *IstioReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		}
 	}
 
-	err = r.CrdsReconciler.Reconcile(logger)
+	if devopsv1beta1.IsUnmanaged(config) {
+		return r.reportUnmanaged(config, logger)
+	}
+
+	err = config.Status.Version.ValidateUpgrade(config.Spec.Version)
+	if err == nil {
+		err = config.Spec.Istiod.ValidateRevisions()
+	}
 	if err != nil {
-		logger.Error(err, "failed to Reconcile istio crd")
+		logger.Error(err, "invalid istio version")
+		updateErr := r.updateStatus(config, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+		if updateErr != nil {
+			return reconcile.Result{}, errors.WithStack(updateErr)
+		}
+		// nothing to retry until the version is changed
+		return reconcile.Result{}, nil
 	}
 
-	reconcilers := []resources.ComponentReconciler{
-		base.New(r.Client, config, false),
-		istiod.New(r.Client, r.dynamic, config),
-		cni.New(r.Client, config),
-		istiocoredns.New(r.Client, config),
-		proxywasm.New(r.Client, r.dynamic, config),
-		ingressgateway.New(r.Client, r.dynamic, config),
-		egressgateway.New(r.Client, r.dynamic, config),
+	if devopsv1beta1.IsDryRun(config) {
+		return r.reportDryRun(config, logger)
 	}
+	config.Status.PendingChanges = nil
 
-	for _, rec := range reconcilers {
-		err = rec.Reconcile(logger)
+	upgrading := config.Status.Version != "" && config.Status.Version != config.Spec.Version
+	if upgrading && config.Status.Status != devopsv1beta1.Upgrading {
+		logger.Info("upgrading control plane", "from", config.Status.Version, "to", config.Spec.Version)
+		err := r.updateStatus(config, devopsv1beta1.Upgrading, "", logger)
 		if err != nil {
 			return reconcile.Result{}, err
 		}
 	}
 
+	// the changes made by the components are reported as events on the istio resource
+	c := k8sutils.WithEvents(r.Client, r.recorder, config)
+	dc := k8sutils.WithDynamicEvents(r.dynamic, r.recorder, config)
+
+	err = r.CrdsReconciler.Reconcile(logger)
+	if err != nil {
+		if upgrading {
+			// the new control plane version must not be rolled out without its CRDs
+			updateErr := r.updateStatus(config, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+			if updateErr != nil {
+				logger.Error(updateErr, "failed to update state")
+			}
+			return reconcile.Result{}, emperror.Wrap(err, "could not upgrade istio crds")
+		}
+		logger.Error(err, "failed to Reconcile istio crd")
+	} else {
+		err = r.watchIstioResources()
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// a failing or rolling out control plane only holds back the components depending on it
+	errs := r.reconcileComponents(config, r.components(c, dc, config), logger)
+	if len(errs) > 0 {
+		return r.reportComponentErrors(config, errs, logger)
+	}
+
 	if utils.PointerToBool(config.Spec.Gateways.Enabled) && utils.PointerToBool(config.Spec.Gateways.IngressConfig.Enabled) {
-		config.Status.GatewayAddress, err = GetMeshGatewayAddress(r.Client, client.ObjectKey{
+		config.Status.GatewayEndpoints, err = GetMeshGatewayEndpoints(r.Client, client.ObjectKey{
 			Name:      ingressgateway.ResourceName,
 			Namespace: config.Namespace,
 		})
+		config.Status.GatewayAddress = k8sutils.EndpointAddresses(config.Status.GatewayEndpoints)
 		if err != nil {
 			logger.Error(err, "ingress gateway address pending")
+			metrics.GatewayAddressPending("Istio", config.Namespace, config.Name)
+			r.recorder.Event(config, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonAddressPending, err.Error())
+			devopsv1beta1.SetCondition(&config.Status.Conditions, devopsv1beta1.Condition{
+				Type:               devopsv1beta1.ConditionTypeIngressGateway,
+				Status:             corev1.ConditionFalse,
+				ObservedGeneration: config.Generation,
+				Reason:             devopsv1beta1.ConditionReasonAddressPending,
+				Message:            err.Error(),
+			})
 			r.updateStatus(config, devopsv1beta1.ReconcileFailed, err.Error(), logger)
-			return reconcile.Result{
-				Requeue:      true,
-				RequeueAfter: time.Duration(30) * time.Second,
-			}, nil
+			// the owned mesh gateway is watched, its status change triggers the next reconciliation
+			return reconcile.Result{}, nil
 		}
+		metrics.GatewayAddressAssigned("Istio", config.Namespace, config.Name)
 	}
 
 	err = r.updateStatus(config, devopsv1beta1.Available, "", logger)
@@ -212,6 +310,7 @@ func (r *IstioReconciler) updateStatus(config *devopsv1beta1.Istio, status devop
 	typeMeta := config.TypeMeta
 	config.Status.Status = status
 	config.Status.ErrorMessage = errorMessage
+	config.Status.ObservedGeneration = config.Generation
 	err := r.Client.Status().Update(context.Background(), config)
 	if apierrors.IsNotFound(err) {
 		err = r.Client.Update(context.Background(), config)
@@ -229,7 +328,14 @@ func (r *IstioReconciler) updateStatus(config *devopsv1beta1.Istio, status devop
 			return emperror.Wrap(err, "could not get config for updating status")
 		}
 		actualConfig.Status.Status = status
+		actualConfig.Status.Version = config.Status.Version
 		actualConfig.Status.ErrorMessage = errorMessage
+		actualConfig.Status.ObservedGeneration = config.Status.ObservedGeneration
+		actualConfig.Status.Conditions = config.Status.Conditions
+		actualConfig.Status.MTLS = config.Status.MTLS
+		actualConfig.Status.PendingChanges = config.Status.PendingChanges
+		actualConfig.Status.GatewayAddress = config.Status.GatewayAddress
+		actualConfig.Status.GatewayEndpoints = config.Status.GatewayEndpoints
 		err = r.Client.Status().Update(context.Background(), &actualConfig)
 		if apierrors.IsNotFound(err) {
 			err = r.Client.Update(context.Background(), &actualConfig)
@@ -240,6 +346,7 @@ func (r *IstioReconciler) updateStatus(config *devopsv1beta1.Istio, status devop
 	}
 	// update loses the typeMeta of the config that's used later when setting ownerrefs
 	config.TypeMeta = typeMeta
+	metrics.SetConfigState("Istio", config.Namespace, config.Name, status)
 	logger.Info("Istio state updated", "status", status)
 	return nil
 }
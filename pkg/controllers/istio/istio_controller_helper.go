@@ -3,27 +3,496 @@ package istio
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
 	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/autoinjection"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/base"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/cni"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/egressgateway"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/grafana"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/ingressgateway"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/istiocoredns"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/istiod"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/kiali"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/prometheus"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/proxywasm"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/utils"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
-func GetMeshGatewayAddress(client client.Client, key client.ObjectKey) ([]string, error) {
-	var mgw devopsv1beta1.MeshGateway
+// ownedResources are the namespaced kinds created by the component reconcilers, owned by the Istio resource
+var ownedResources = []runtime.Object{
+	&corev1.ServiceAccount{},
+	&corev1.ConfigMap{},
+	&corev1.Service{},
+	&appsv1.Deployment{},
+	&appsv1.DaemonSet{},
+	&autoscalingv2beta1.HorizontalPodAutoscaler{},
+	&policyv1beta1.PodDisruptionBudget{},
+	&devopsv1beta1.MeshGateway{},
+}
+
+// ownedClusterResources are the cluster scoped kinds created by the component reconcilers,
+// mapped back to the Istio resource through their owner labels
+var ownedClusterResources = []runtime.Object{
+	&rbacv1.ClusterRole{},
+	&rbacv1.ClusterRoleBinding{},
+	&admissionv1beta1.MutatingWebhookConfiguration{},
+	&admissionv1beta1.ValidatingWebhookConfiguration{},
+}
+
+// ownedIstioResources are the Istio networking kinds created by the component reconcilers
+var ownedIstioResources = []schema.GroupVersionKind{
+	{Group: "networking.istio.io", Version: "v1alpha3", Kind: "DestinationRule"},
+	{Group: "networking.istio.io", Version: "v1alpha3", Kind: "EnvoyFilter"},
+	{Group: "networking.istio.io", Version: "v1alpha3", Kind: "Gateway"},
+	{Group: "networking.istio.io", Version: "v1alpha3", Kind: "VirtualService"},
+}
+
+// labeledIstioResources are the Istio kinds created outside of the namespace of the Istio resource as well,
+// mapped back to it through their owner labels
+var labeledIstioResources = []schema.GroupVersionKind{
+	{Group: "security.istio.io", Version: "v1beta1", Kind: "PeerAuthentication"},
+}
 
-	ips := make([]string, 0)
+// GetMeshGatewayEndpoints returns the endpoints of the available mesh gateway, the changes of its status
+// enqueue the owning Istio resource
+func GetMeshGatewayEndpoints(client client.Client, key client.ObjectKey) ([]devopsv1beta1.GatewayEndpoint, error) {
+	var mgw devopsv1beta1.MeshGateway
 
 	err := client.Get(context.TODO(), key, &mgw)
 	if err != nil && !k8serrors.IsNotFound(err) {
-		return ips, err
+		return nil, err
 	}
 
 	if mgw.Status.Status != devopsv1beta1.Available {
-		return ips, errors.New("gateway is pending")
+		return nil, errors.New("gateway is pending")
+	}
+
+	return mgw.Status.GatewayEndpoints, nil
+}
+
+// istiosForRemoteIstio enqueues the Istio control plane of the changed RemoteIstio,
+// so that the mesh networks follow the gateway addresses of the remote clusters
+func (r *IstioReconciler) istiosForRemoteIstio(o handler.MapObject) []reconcile.Request {
+	var configs devopsv1beta1.IstioList
+	err := r.Client.List(context.TODO(), &configs, client.InNamespace(o.Meta.GetNamespace()))
+	if err != nil {
+		r.Log.Error(err, "could not list istio resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(configs.Items))
+	for _, config := range configs.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+			Name:      config.Name,
+			Namespace: config.Namespace,
+		}})
+	}
+
+	return requests
+}
+
+// namespaceLabelsPredicate passes the created namespaces and the ones whose labels changed
+var namespaceLabelsPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return true
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return !reflect.DeepEqual(e.MetaOld.GetLabels(), e.MetaNew.GetLabels())
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// istiosForNamespace enqueues the Istio control planes whose auto injection settings select the namespace,
+// and the one that labeled it, so that the labels are removed once it is no longer selected
+func (r *IstioReconciler) istiosForNamespace(o handler.MapObject) []reconcile.Request {
+	var configs devopsv1beta1.IstioList
+	err := r.Client.List(context.TODO(), &configs)
+	if err != nil {
+		r.Log.Error(err, "could not list istio resources")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, config := range configs.Items {
+		selected, err := autoinjection.Selects(config.Spec.AutoInjectionNamespaces, config.Spec.AutoInjectionNamespaceSelector, o.Meta)
+		if err != nil {
+			r.Log.Error(err, "could not match namespace", "namespace", o.Meta.GetName(), "istio", config.Name)
+		}
+		if !selected && o.Meta.GetLabels()[autoinjection.ManagedLabel] != autoinjection.Owner(&config) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+			Name:      config.Name,
+			Namespace: config.Namespace,
+		}})
+	}
+
+	return requests
+}
+
+// watchIstioResources watches the Istio networking objects owned by the Istio resource,
+// it must only be called after the Istio CRDs are installed
+func (r *IstioReconciler) watchIstioResources() error {
+	for _, gvk := range ownedIstioResources {
+		err := r.watchIstioResource(gvk, &handler.EnqueueRequestForOwner{
+			IsController: true,
+			OwnerType:    &devopsv1beta1.Istio{},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, gvk := range labeledIstioResources {
+		err := r.watchIstioResource(gvk, k8sutils.EnqueueRequestForOwnerLabels("Istio"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *IstioReconciler) watchIstioResource(gvk schema.GroupVersionKind, eventHandler handler.EventHandler) error {
+	if r.watchedIstioResources[gvk] {
+		return nil
+	}
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	err := r.controller.Watch(&source.Kind{Type: u}, eventHandler)
+	if err != nil {
+		return emperror.WrapWith(err, "could not watch istio resources", "kind", gvk.Kind)
+	}
+	r.watchedIstioResources[gvk] = true
+
+	return nil
+}
+
+// finalize tears down the control plane before releasing the deleted Istio resource
+func (r *IstioReconciler) finalize(config *devopsv1beta1.Istio, logger logr.Logger) (reconcile.Result, error) {
+	if !utils.ContainsString(config.Finalizers, finalizerID) {
+		return reconcile.Result{}, nil
+	}
+
+	defaulted := config.DeepCopy()
+	devopsv1beta1.SetDefaults(defaulted)
+	err := r.cleanup(defaulted, logger)
+	if err != nil {
+		r.recorder.Eventf(config, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonReconcileFailed, "cleanup failed: %v", err)
+		return reconcile.Result{}, emperror.Wrap(err, "could not clean up istio")
+	}
+
+	config.Finalizers = utils.RemoveString(config.Finalizers, finalizerID)
+	err = r.Client.Update(context.Background(), config)
+	if err != nil {
+		return reconcile.Result{}, emperror.Wrap(err, "could not remove finalizer")
+	}
+	logger.Info("istio cleaned up")
+
+	return reconcile.Result{}, nil
+}
+
+// cleanup runs every component with every resource absent, in the reverse order of the installation, to remove the
+// cluster scoped and out of namespace resources owner references cannot clean up.
+// The CRDs are kept, removing them would delete every Istio resource of the cluster.
+func (r *IstioReconciler) cleanup(config *devopsv1beta1.Istio, logger logr.Logger) error {
+	c := k8sutils.WithEvents(r.Client, r.recorder, config)
+	dc := k8sutils.WithDynamicEvents(r.dynamic, r.recorder, config)
+	for _, rec := range []resources.ComponentCleaner{
+		kiali.New(c, config),
+		grafana.New(c, config),
+		prometheus.New(c, dc, config),
+		autoinjection.New(c, config, config.Spec.AutoInjectionNamespaces, config.Spec.AutoInjectionNamespaceSelector),
+		egressgateway.New(c, dc, config),
+		ingressgateway.New(c, dc, config),
+		proxywasm.New(c, dc, config),
+		istiocoredns.New(c, config),
+		cni.New(c, config),
+		istiod.New(c, dc, config),
+		base.New(c, dc, config, false),
+	} {
+		err := rec.Cleanup(logger)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reportUnmanaged only refreshes the status of the paused Istio resource, none of the components is reconciled
+// so that the control plane can be patched by hand
+func (r *IstioReconciler) reportUnmanaged(config *devopsv1beta1.Istio, logger logr.Logger) (reconcile.Result, error) {
+	logger.Info("istio is unmanaged, skipping reconciliation")
+
+	if utils.PointerToBool(config.Spec.Gateways.Enabled) && utils.PointerToBool(config.Spec.Gateways.IngressConfig.Enabled) {
+		endpoints, err := GetMeshGatewayEndpoints(r.Client, client.ObjectKey{
+			Name:      ingressgateway.ResourceName,
+			Namespace: config.Namespace,
+		})
+		if err == nil {
+			config.Status.GatewayEndpoints = endpoints
+			config.Status.GatewayAddress = k8sutils.EndpointAddresses(endpoints)
+		}
+	}
+
+	err := r.updateStatus(config, devopsv1beta1.Unmanaged, "", logger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// reportDryRun records the changes the components would make into the status of the Istio resource
+// instead of applying them
+func (r *IstioReconciler) reportDryRun(config *devopsv1beta1.Istio, logger logr.Logger) (reconcile.Result, error) {
+	logger.Info("istio is in dry-run mode, recording the pending changes")
+
+	changes, err := DryRun(logger, r.Client, r.dynamic, r.CrdsReconciler, config)
+	config.Status.PendingChanges = changes
+	if err != nil {
+		updateErr := r.updateStatus(config, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+		return reconcile.Result{}, err
+	}
+
+	err = r.updateStatus(config, devopsv1beta1.DryRun, "", logger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+var (
+	dependsOnBase   = []devopsv1beta1.ConditionType{devopsv1beta1.ConditionTypeBase}
+	dependsOnIstiod = []devopsv1beta1.ConditionType{devopsv1beta1.ConditionTypeIstiod}
+)
+
+// ControlPlaneComponents returns the components of the control plane, they are rolled out before any other
+func ControlPlaneComponents(c client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio) []resources.Component {
+	return []resources.Component{
+		{ConditionType: devopsv1beta1.ConditionTypeBase, Reconciler: base.New(c, dc, config, false)},
+		{ConditionType: devopsv1beta1.ConditionTypeIstiod, Reconciler: istiod.New(c, dc, config), DependsOn: dependsOnBase},
+	}
+}
+
+// Components returns the components reconciled once the control plane is rolled out, the ones served by istiod
+// are skipped while it fails
+func Components(c client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio) []resources.Component {
+	return []resources.Component{
+		{ConditionType: devopsv1beta1.ConditionTypeCNI, Reconciler: cni.New(c, config)},
+		{ConditionType: devopsv1beta1.ConditionTypeCoreDNS, Reconciler: istiocoredns.New(c, config)},
+		{ConditionType: devopsv1beta1.ConditionTypeProxyWasm, Reconciler: proxywasm.New(c, dc, config), DependsOn: dependsOnIstiod},
+		{ConditionType: devopsv1beta1.ConditionTypeIngressGateway, Reconciler: ingressgateway.New(c, dc, config), DependsOn: dependsOnIstiod},
+		{ConditionType: devopsv1beta1.ConditionTypeEgressGateway, Reconciler: egressgateway.New(c, dc, config), DependsOn: dependsOnIstiod},
+		{ConditionType: devopsv1beta1.ConditionTypeAutoInjection, Reconciler: autoinjection.New(c, config, config.Spec.AutoInjectionNamespaces, config.Spec.AutoInjectionNamespaceSelector), DependsOn: dependsOnIstiod},
+		{ConditionType: devopsv1beta1.ConditionTypePrometheus, Reconciler: prometheus.New(c, dc, config)},
+		{ConditionType: devopsv1beta1.ConditionTypeGrafana, Reconciler: grafana.New(c, config)},
+		{ConditionType: devopsv1beta1.ConditionTypeKiali, Reconciler: kiali.New(c, config)},
+	}
+}
+
+// components returns the control plane and the components depending on it, istiod only succeeds once it is rolled out
+// in the requested version so that the components depending on it wait for it
+func (r *IstioReconciler) components(c client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio) []resources.Component {
+	components := append(ControlPlaneComponents(c, dc, config), Components(c, dc, config)...)
+	for i := range components {
+		if components[i].ConditionType == devopsv1beta1.ConditionTypeIstiod {
+			components[i].Reconciler = &rolloutGate{
+				ComponentReconciler: components[i].Reconciler,
+				client:              r.Client,
+				config:              config,
+			}
+		}
+	}
+
+	return components
+}
+
+// rolloutInProgressError is returned for istiod while the istiod of the requested version is rolling out
+type rolloutInProgressError struct {
+	version devopsv1beta1.IstioVersion
+}
+
+func (e rolloutInProgressError) Error() string {
+	return fmt.Sprintf("waiting for istiod %s to be rolled out", e.version)
+}
+
+// rolloutGate reconciles istiod and fails until it is rolled out when the version of the control plane changes,
+// the rolled out version is set on the status then, before the components depending on istiod run
+type rolloutGate struct {
+	resources.ComponentReconciler
+	client client.Client
+	config *devopsv1beta1.Istio
+}
+
+func (g *rolloutGate) Reconcile(log logr.Logger) error {
+	err := g.ComponentReconciler.Reconcile(log)
+	if err != nil || g.config.Status.Version == g.config.Spec.Version {
+		return err
+	}
+
+	rolledOut, err := istiod.IsRolledOut(g.client, g.config)
+	if err != nil {
+		return err
 	}
+	if !rolledOut {
+		return rolloutInProgressError{version: g.config.Spec.Version}
+	}
+
+	log.Info("control plane rolled out", "version", g.config.Spec.Version)
+	g.config.Status.Version = g.config.Spec.Version
+
+	return nil
+}
+
+// waitingForRollout returns the components held back by the rollout of istiod, istiod itself included
+func waitingForRollout(errs resources.ComponentErrors) map[devopsv1beta1.ConditionType]bool {
+	waiting := make(map[devopsv1beta1.ConditionType]bool)
+	for _, err := range errs {
+		if _, ok := err.Err.(rolloutInProgressError); ok {
+			waiting[err.ConditionType] = true
+		}
+	}
+
+	// a component waits when every dependency it was skipped for waits, transitively
+	for changed := len(waiting) > 0; changed; {
+		changed = false
+		for _, err := range errs {
+			depErr, ok := err.Err.(resources.DependencyError)
+			if !ok || waiting[err.ConditionType] {
+				continue
+			}
+			all := true
+			for _, failed := range depErr.Failed {
+				all = all && waiting[failed]
+			}
+			if all {
+				waiting[err.ConditionType], changed = true, true
+			}
+		}
+	}
+
+	return waiting
+}
+
+// reconcileComponents runs the components along their dependencies, a failing component is reported as an event
+// and only stops the components depending on it
+func (r *IstioReconciler) reconcileComponents(config *devopsv1beta1.Istio, components []resources.Component, logger logr.Logger) resources.ComponentErrors {
+	errs := resources.ReconcileComponents(logger, components, &config.Status.Conditions, config.Generation, r.threadiness)
+	waiting := waitingForRollout(errs)
+	for _, err := range errs {
+		if waiting[err.ConditionType] {
+			continue
+		}
+		logger.Error(err.Err, "component failed", "component", err.ConditionType)
+		r.recorder.Eventf(config, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonReconcileFailed, "component %s failed: %v", err.ConditionType, err.Err)
+	}
+
+	return errs
+}
 
-	ips = mgw.Status.GatewayAddress
+// reportComponentErrors persists the conditions of the failed components and returns their aggregated error,
+// the components only waiting for the rollout of istiod are reported as such and checked again later
+func (r *IstioReconciler) reportComponentErrors(config *devopsv1beta1.Istio, errs resources.ComponentErrors, logger logr.Logger) (reconcile.Result, error) {
+	waiting := waitingForRollout(errs)
+	var failed resources.ComponentErrors
+	for _, err := range errs {
+		if !waiting[err.ConditionType] {
+			failed = append(failed, err)
+			continue
+		}
+		devopsv1beta1.SetCondition(&config.Status.Conditions, devopsv1beta1.Condition{
+			Type:               err.ConditionType,
+			Status:             corev1.ConditionFalse,
+			ObservedGeneration: config.Generation,
+			Reason:             devopsv1beta1.ConditionReasonRolloutInProgress,
+			Message:            rolloutInProgressError{version: config.Spec.Version}.Error(),
+		})
+	}
+
+	if len(failed) > 0 {
+		updateErr := r.updateStatus(config, devopsv1beta1.ReconcileFailed, failed.Error(), logger)
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+
+		return reconcile.Result{}, failed
+	}
+
+	logger.Info("waiting for istiod to be rolled out", "version", config.Spec.Version)
+	r.recorder.Eventf(config, corev1.EventTypeNormal, devopsv1beta1.ConditionReasonRolloutInProgress, "waiting for istiod %s to be rolled out", config.Spec.Version)
+	err := r.updateStatus(config, config.Status.Status, "", logger)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{
+		RequeueAfter: time.Duration(10) * time.Second,
+	}, nil
+}
+
+// DryRun runs the CRDs, when given, and the components of the Istio resource against dry-run clients and returns
+// the changes they would make, up to the first failing component
+func DryRun(log logr.Logger, c client.Client, dc dynamic.Interface, crds *k8sutils.CRDReconciler, config *devopsv1beta1.Istio) ([]devopsv1beta1.ResourceChange, error) {
+	changes := k8sutils.NewChangeRecorder()
+	c = k8sutils.WithDryRun(c, changes)
+	dc = k8sutils.WithDynamicDryRun(dc, changes)
+
+	config = config.DeepCopy()
+	devopsv1beta1.SetDefaults(config)
+
+	if crds != nil {
+		err := crds.DryRun(changes).Reconcile(log)
+		if err != nil {
+			return changes.Changes(), emperror.Wrap(err, "could not reconcile istio crds")
+		}
+	}
+
+	// the dependent components are compared as they would be once the control plane is rolled out
+	rolledOut := config.DeepCopy()
+	rolledOut.Status.Version = config.Spec.Version
+	components := append(ControlPlaneComponents(c, dc, config), Components(c, dc, rolledOut)...)
+	for _, component := range components {
+		err := component.Reconciler.Reconcile(log)
+		if err != nil {
+			return changes.Changes(), emperror.Wrapf(err, "could not reconcile component '%s'", component.ConditionType)
+		}
+	}
 
-	return ips, nil
+	return changes.Changes(), nil
 }
@@ -2,6 +2,7 @@ package meshgateway
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"time"
 
@@ -10,7 +11,12 @@ import (
 	"github.com/goph/emperror"
 	"github.com/pkg/errors"
 	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
 	"github.com/symcn/mid-operator/pkg/controllers/resources/gateways"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/istiod"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/metrics"
+	"github.com/symcn/mid-operator/pkg/utils"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -20,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -33,6 +40,11 @@ import (
 
 var log = logf.Log.WithName("controller")
 
+const finalizerID = "meshgateway.devops.symcn.com"
+
+// controlPlaneWaitPeriod is how often a gateway waiting for istiod checks the wait timeout
+const controlPlaneWaitPeriod = 5 * time.Second
+
 func GetWatchPredicateForMeshGateway() predicate.Funcs {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
@@ -45,6 +57,8 @@ func GetWatchPredicateForMeshGateway() predicate.Funcs {
 			oldObj := e.ObjectOld.(*devopsv1beta1.MeshGateway)
 			newObj := e.ObjectNew.(*devopsv1beta1.MeshGateway)
 			if !reflect.DeepEqual(oldObj.Spec, newObj.Spec) ||
+				oldObj.GetAnnotations()[devopsv1beta1.IstioVersionAnnotation] != newObj.GetAnnotations()[devopsv1beta1.IstioVersionAnnotation] ||
+				devopsv1beta1.IsUnmanaged(oldObj) != devopsv1beta1.IsUnmanaged(newObj) ||
 				oldObj.GetDeletionTimestamp() != newObj.GetDeletionTimestamp() ||
 				oldObj.GetGeneration() != newObj.GetGeneration() {
 				return true
@@ -56,18 +70,25 @@ func GetWatchPredicateForMeshGateway() predicate.Funcs {
 
 // Add creates a new MeshGateway Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
-func Add(mgr manager.Manager) error {
+// The gateways wait for istiod to be ready up to the controlPlaneWaitTimeout, forever when it is 0.
+func Add(mgr manager.Manager, controlPlaneWaitTimeout time.Duration) error {
 	dy, err := dynamic.NewForConfig(mgr.GetConfig())
 	if err != nil {
 		return emperror.Wrap(err, "failed to create dynamic client")
 	}
 
-	return add(mgr, newReconciler(mgr, dy))
+	return add(mgr, newReconciler(mgr, dy, controlPlaneWaitTimeout))
 }
 
 // newReconciler returns a new reconcile.Reconciler
-func newReconciler(mgr manager.Manager, d dynamic.Interface) reconcile.Reconciler {
-	return &ReconcileMeshGateway{Client: mgr.GetClient(), dynamic: d, scheme: mgr.GetScheme()}
+func newReconciler(mgr manager.Manager, d dynamic.Interface, controlPlaneWaitTimeout time.Duration) reconcile.Reconciler {
+	return &ReconcileMeshGateway{
+		Client:                  mgr.GetClient(),
+		dynamic:                 d,
+		scheme:                  mgr.GetScheme(),
+		recorder:                mgr.GetEventRecorderFor("meshgateway-controller"),
+		controlPlaneWaitTimeout: controlPlaneWaitTimeout,
+	}
 }
 
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
@@ -84,6 +105,30 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	// Watch for changes to the control planes the gateways belong to
+	err = c.Watch(&source.Kind{Type: &devopsv1beta1.Istio{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: meshGatewaysForIstio(mgr.GetClient()),
+	}, istioPredicate)
+	if err != nil {
+		return err
+	}
+
+	// Watch for istiod becoming ready, the gateways of its control plane wait for it
+	err = c.Watch(&source.Kind{Type: &appsv1.Deployment{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: meshGatewaysForIstiod(mgr.GetClient()),
+	}, istiodPredicate)
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the addresses of the nodes the NodePort gateways are exposed on
+	err = c.Watch(&source.Kind{Type: &corev1.Node{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: nodePortMeshGateways(mgr.GetClient()),
+	}, nodePredicate)
+	if err != nil {
+		return err
+	}
+
 	// Watch for changes to resources created by the controller
 	for _, t := range []runtime.Object{
 		&corev1.ServiceAccount{TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"}},
@@ -112,15 +157,19 @@ var _ reconcile.Reconciler = &ReconcileMeshGateway{}
 // ReconcileMeshGateway reconciles a MeshGateway object
 type ReconcileMeshGateway struct {
 	client.Client
-	dynamic dynamic.Interface
-	scheme  *runtime.Scheme
+	dynamic  dynamic.Interface
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+	// controlPlaneWaitTimeout is how long a gateway waits for istiod, forever when 0
+	controlPlaneWaitTimeout time.Duration
 }
 
+// +kubebuilder:rbac:groups=devops.symcn.com,resources=meshgateways;meshgateways/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=devops.symcn.com,resources=meshgateways/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
 // Reconcile reads that state of the cluster for a MeshGateway object and makes changes based on the state read
 // and what is in the MeshGateway.Spec
-// Automatically generate RBAC rules to allow the Controller to read and write Deployments
-// +kubebuilder:rbac:groups=istio.banzaicloud.io,resources=meshgateways;meshgateways/finalizers,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=istio.banzaicloud.io,resources=meshgateways/status,verbs=get;update;patch
 func (r *ReconcileMeshGateway) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	logger := log.WithValues("trigger", request.Namespace+"/"+request.Name, "correlationID", uuid.Must(uuid.NewV4()).String())
 
@@ -129,35 +178,88 @@ func (r *ReconcileMeshGateway) Reconcile(request reconcile.Request) (reconcile.R
 	err := r.Get(context.TODO(), request.NamespacedName, instance)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
+			metrics.Forget("MeshGateway", request.Namespace, request.Name)
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.finalize(instance, logger)
+	}
+
+	if !utils.ContainsString(instance.Finalizers, finalizerID) {
+		typeMeta := instance.TypeMeta
+		instance.Finalizers = append(instance.Finalizers, finalizerID)
+		err = r.Update(context.TODO(), instance)
+		if err != nil {
+			return reconcile.Result{}, emperror.Wrap(err, "could not add finalizer")
+		}
+		instance.TypeMeta = typeMeta
+	}
 	instance.SetDefaults()
 
+	if devopsv1beta1.IsUnmanaged(instance) {
+		return r.reportUnmanaged(instance, logger)
+	}
+
 	err = updateStatus(r.Client, instance, devopsv1beta1.Reconciling, "", logger)
 	if err != nil {
 		return reconcile.Result{}, errors.WithStack(err)
 	}
 
-	istio, err := r.getIstioForMeshGateway()
+	istio, err := r.getIstioForMeshGateway(instance)
 	if err != nil {
-		log.Error(err, "failed to get istio")
-		return reconcile.Result{}, err
+		return r.reportControlPlaneError(instance, err, logger)
 	}
+	instance.Status.IstioControlPlane = &devopsv1beta1.IstioControlPlaneReference{
+		Name:      istio.Name,
+		Namespace: istio.Namespace,
+	}
+	devopsv1beta1.SetCondition(&instance.Status.Conditions, devopsv1beta1.Condition{
+		Type:               devopsv1beta1.ConditionTypeControlPlane,
+		Status:             corev1.ConditionTrue,
+		ObservedGeneration: instance.Generation,
+		Reason:             devopsv1beta1.ConditionReasonReconciled,
+	})
 
-	reconciler := gateways.New(r.Client, r.dynamic, istio, instance)
-	err = reconciler.Reconcile(log)
+	reconciler := gateways.New(k8sutils.WithEvents(r.Client, r.recorder, instance), k8sutils.WithDynamicEvents(r.dynamic, r.recorder, instance), istio, instance)
+	ready, err := istiod.IsReady(r.Client, istio)
+	if err == nil && !ready {
+		return r.waitForControlPlane(istio, instance, logger)
+	}
 	if err == nil {
-		instance.Status.GatewayAddress, err = reconciler.GetGatewayAddress()
+		err = resources.ReconcileComponent(log, resources.Component{
+			ConditionType: devopsv1beta1.ConditionTypeGateway,
+			Reconciler:    reconciler,
+		}, &instance.Status.Conditions, instance.Generation)
+	}
+	if err == nil {
+		instance.Status.Version = istio.Spec.Version
+		instance.Status.GatewayEndpoints, err = reconciler.GetGatewayEndpoints()
+		instance.Status.GatewayAddress = k8sutils.EndpointAddresses(instance.Status.GatewayEndpoints)
 		if err != nil {
 			log.Error(err, "gateway address pending")
-			return reconcile.Result{
-				RequeueAfter: time.Second * 30,
-			}, nil
+			metrics.GatewayAddressPending("MeshGateway", instance.Namespace, instance.Name)
+			r.recorder.Event(instance, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonAddressPending, err.Error())
+			devopsv1beta1.SetCondition(&instance.Status.Conditions, devopsv1beta1.Condition{
+				Type:               devopsv1beta1.ConditionTypeGateway,
+				Status:             corev1.ConditionFalse,
+				ObservedGeneration: instance.Generation,
+				Reason:             devopsv1beta1.ConditionReasonAddressPending,
+				Message:            err.Error(),
+			})
+			updateErr := updateStatus(r.Client, instance, devopsv1beta1.Reconciling, "", logger)
+			if updateErr != nil {
+				logger.Error(updateErr, "failed to update state")
+			}
+			// the gateway service and the nodes are watched, their changes trigger the next reconciliation
+			return reconcile.Result{}, nil
 		}
+		metrics.GatewayAddressAssigned("MeshGateway", instance.Namespace, instance.Name)
 	} else {
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonReconcileFailed, "component %s failed: %v", devopsv1beta1.ConditionTypeGateway, err)
 		updateErr := updateStatus(r.Client, instance, devopsv1beta1.ReconcileFailed, err.Error(), logger)
 		if updateErr != nil {
 			logger.Error(updateErr, "failed to update state")
@@ -178,6 +280,7 @@ func updateStatus(c client.Client, instance *devopsv1beta1.MeshGateway, status d
 	typeMeta := instance.TypeMeta
 	instance.Status.Status = status
 	instance.Status.ErrorMessage = errorMessage
+	instance.Status.ObservedGeneration = instance.Generation
 	err := c.Status().Update(context.Background(), instance)
 	if k8serrors.IsNotFound(err) {
 		err = c.Update(context.Background(), instance)
@@ -196,6 +299,12 @@ func updateStatus(c client.Client, instance *devopsv1beta1.MeshGateway, status d
 		}
 		actualInstance.Status.Status = status
 		actualInstance.Status.ErrorMessage = errorMessage
+		actualInstance.Status.GatewayAddress = instance.Status.GatewayAddress
+		actualInstance.Status.GatewayEndpoints = instance.Status.GatewayEndpoints
+		actualInstance.Status.Version = instance.Status.Version
+		actualInstance.Status.IstioControlPlane = instance.Status.IstioControlPlane
+		actualInstance.Status.ObservedGeneration = instance.Status.ObservedGeneration
+		actualInstance.Status.Conditions = instance.Status.Conditions
 		err = c.Status().Update(context.Background(), &actualInstance)
 		if k8serrors.IsNotFound(err) {
 			err = c.Update(context.Background(), &actualInstance)
@@ -207,21 +316,154 @@ func updateStatus(c client.Client, instance *devopsv1beta1.MeshGateway, status d
 
 	// update loses the typeMeta of the instace that's used later when setting ownerrefs
 	instance.TypeMeta = typeMeta
+	metrics.SetConfigState("MeshGateway", instance.Namespace, instance.Name, status)
 	logger.Info("mesh gateway state updated", "status", status)
 	return nil
 }
 
-func (r *ReconcileMeshGateway) getIstioForMeshGateway() (*devopsv1beta1.Istio, error) {
+// getIstioForMeshGateway returns the Istio of the control plane the gateway belongs to
+func (r *ReconcileMeshGateway) getIstioForMeshGateway(instance *devopsv1beta1.MeshGateway) (*devopsv1beta1.Istio, error) {
 	var configs devopsv1beta1.IstioList
 	err := r.Client.List(context.TODO(), &configs, &client.ListOptions{})
 	if err != nil {
 		return nil, emperror.Wrap(err, "could not list istio resources")
 	}
 
-	if len(configs.Items) != 1 {
-		return nil, emperror.Wrap(err, "could not found istio resource")
+	istio, err := ControlPlaneOf(instance, configs.Items)
+	if err != nil {
+		return nil, err
+	}
+	// gateways follow the rolled out control plane version, not the desired one
+	if istio.Status.Version != "" {
+		istio.Spec.Version = istio.Status.Version
 	}
+	devopsv1beta1.SetDefaults(istio)
 
-	istio := &configs.Items[0]
 	return istio, nil
 }
+
+// reportControlPlaneError reports the gateway whose control plane cannot be told in its status,
+// it is reconciled again once an Istio changes
+func (r *ReconcileMeshGateway) reportControlPlaneError(instance *devopsv1beta1.MeshGateway, err error, logger logr.Logger) (reconcile.Result, error) {
+	cpErr, ok := err.(*controlPlaneError)
+	if !ok {
+		logger.Error(err, "failed to get istio")
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("control plane of the gateway not found", "reason", cpErr.reason, "error", cpErr.message)
+	r.recorder.Event(instance, corev1.EventTypeWarning, cpErr.reason, cpErr.message)
+	instance.Status.IstioControlPlane = nil
+	devopsv1beta1.SetCondition(&instance.Status.Conditions, devopsv1beta1.Condition{
+		Type:               devopsv1beta1.ConditionTypeControlPlane,
+		Status:             corev1.ConditionFalse,
+		ObservedGeneration: instance.Generation,
+		Reason:             cpErr.reason,
+		Message:            cpErr.message,
+	})
+	err = updateStatus(r.Client, instance, devopsv1beta1.ReconcileFailed, cpErr.message, logger)
+	if err != nil {
+		return reconcile.Result{}, errors.WithStack(err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// waitForControlPlane holds the gateway back until istiod is ready, the gateway could not get its config otherwise.
+// The gateway is enqueued once istiod becomes ready, the requeue only bounds the wait.
+func (r *ReconcileMeshGateway) waitForControlPlane(istio *devopsv1beta1.Istio, instance *devopsv1beta1.MeshGateway, logger logr.Logger) (reconcile.Result, error) {
+	message := fmt.Sprintf("waiting for istiod of %s/%s to be ready", istio.Namespace, istio.Name)
+	previous := instance.Status.DeepCopy()
+	devopsv1beta1.SetCondition(&instance.Status.Conditions, devopsv1beta1.Condition{
+		Type:               devopsv1beta1.ConditionTypeGateway,
+		Status:             corev1.ConditionUnknown,
+		ObservedGeneration: instance.Generation,
+		Reason:             devopsv1beta1.ConditionReasonWaitingForControlPlane,
+		Message:            message,
+	})
+
+	// the condition only transitions to unknown when the wait starts
+	since := devopsv1beta1.FindCondition(instance.Status.Conditions, devopsv1beta1.ConditionTypeGateway).LastTransitionTime
+	if r.controlPlaneWaitTimeout > 0 && time.Since(since.Time) > r.controlPlaneWaitTimeout {
+		err := errors.Errorf("istiod of %s/%s is not ready after %s", istio.Namespace, istio.Name, r.controlPlaneWaitTimeout)
+		r.recorder.Event(instance, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonReconcileFailed, err.Error())
+		updateErr := updateStatus(r.Client, instance, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+		return reconcile.Result{}, err
+	}
+
+	logger.Info(message)
+	// the status is only written when the wait starts, every write enqueues the Istio resource of the gateway
+	if previous.Status != devopsv1beta1.WaitingForControlPlane || previous.ErrorMessage != "" ||
+		previous.ObservedGeneration != instance.Generation || !reflect.DeepEqual(previous.Conditions, instance.Status.Conditions) {
+		err := updateStatus(r.Client, instance, devopsv1beta1.WaitingForControlPlane, "", logger)
+		if err != nil {
+			return reconcile.Result{}, errors.WithStack(err)
+		}
+	}
+
+	return reconcile.Result{
+		RequeueAfter: controlPlaneWaitPeriod,
+	}, nil
+}
+
+// reportUnmanaged only refreshes the status of the paused mesh gateway, its resources are not reconciled
+// so that they can be patched by hand
+func (r *ReconcileMeshGateway) reportUnmanaged(instance *devopsv1beta1.MeshGateway, logger logr.Logger) (reconcile.Result, error) {
+	logger.Info("mesh gateway is unmanaged, skipping reconciliation")
+
+	istio, err := r.getIstioForMeshGateway(instance)
+	if err == nil {
+		endpoints, err := gateways.New(r.Client, r.dynamic, istio, instance).GetGatewayEndpoints()
+		if err == nil {
+			instance.Status.GatewayEndpoints = endpoints
+			instance.Status.GatewayAddress = k8sutils.EndpointAddresses(endpoints)
+		}
+	}
+
+	err = updateStatus(r.Client, instance, devopsv1beta1.Unmanaged, "", logger)
+	if err != nil {
+		return reconcile.Result{}, errors.WithStack(err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// finalize removes the cluster scoped resources of the deleted mesh gateway before releasing it
+func (r *ReconcileMeshGateway) finalize(instance *devopsv1beta1.MeshGateway, logger logr.Logger) (reconcile.Result, error) {
+	if !utils.ContainsString(instance.Finalizers, finalizerID) {
+		return reconcile.Result{}, nil
+	}
+
+	istio, err := r.getIstioForMeshGateway(instance)
+	if err != nil {
+		// the control plane may be deleted first, the resources to remove are identified by name only
+		logger.Info("istio not found, cleaning up mesh gateway with defaults", "error", err.Error())
+		istio = &devopsv1beta1.Istio{}
+		istio.Namespace = instance.Namespace
+		if instance.Status.IstioControlPlane != nil {
+			// the cluster scoped resources only exist for the gateways of the control plane namespace
+			istio.Namespace = instance.Status.IstioControlPlane.Namespace
+		}
+		devopsv1beta1.SetDefaults(istio)
+	}
+
+	defaulted := instance.DeepCopy()
+	defaulted.SetDefaults()
+	err = gateways.New(k8sutils.WithEvents(r.Client, r.recorder, instance), k8sutils.WithDynamicEvents(r.dynamic, r.recorder, instance), istio, defaulted).Cleanup(logger)
+	if err != nil {
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonReconcileFailed, "cleanup failed: %v", err)
+		return reconcile.Result{}, emperror.Wrap(err, "could not clean up mesh gateway")
+	}
+
+	instance.Finalizers = utils.RemoveString(instance.Finalizers, finalizerID)
+	err = r.Update(context.TODO(), instance)
+	if err != nil {
+		return reconcile.Result{}, emperror.Wrap(err, "could not remove finalizer")
+	}
+	logger.Info("mesh gateway cleaned up")
+
+	return reconcile.Result{}, nil
+}
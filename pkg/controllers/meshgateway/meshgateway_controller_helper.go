@@ -0,0 +1,230 @@
+package meshgateway
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/istiod"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// controlPlaneError is returned when the control plane of a gateway cannot be told,
+// its reason is the one of the condition reported in the status of the gateway
+type controlPlaneError struct {
+	reason  string
+	message string
+}
+
+func (e *controlPlaneError) Error() string {
+	return e.message
+}
+
+// ControlPlaneOf returns the Istio of the control plane the gateway belongs to among the given ones: the referenced
+// one, else the only Istio of the namespace of the gateway, else the default control plane of the cluster.
+// A gateway created by an Istio must belong to it, and a gateway outside of the namespace of its control plane must be
+// in one of the namespaces the control plane allows.
+func ControlPlaneOf(instance *devopsv1beta1.MeshGateway, istios []devopsv1beta1.Istio) (*devopsv1beta1.Istio, error) {
+	istio, err := controlPlaneOf(instance, istios)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := metav1.GetControllerOf(instance)
+	if owner != nil && owner.Kind == "Istio" && (owner.Name != istio.Name || instance.Namespace != istio.Namespace) {
+		return nil, &controlPlaneError{
+			reason:  devopsv1beta1.ConditionReasonControlPlaneMismatch,
+			message: fmt.Sprintf("gateway is owned by istio %s/%s but belongs to istio %s/%s", instance.Namespace, owner.Name, istio.Namespace, istio.Name),
+		}
+	}
+
+	if !istio.IsNamespaceAllowed(instance.Namespace) {
+		return nil, &controlPlaneError{
+			reason:  devopsv1beta1.ConditionReasonNamespaceNotAllowed,
+			message: fmt.Sprintf("istio %s/%s does not allow gateways in namespace %s", istio.Namespace, istio.Name, instance.Namespace),
+		}
+	}
+
+	return istio, nil
+}
+
+func controlPlaneOf(instance *devopsv1beta1.MeshGateway, istios []devopsv1beta1.Istio) (*devopsv1beta1.Istio, error) {
+	if ref := instance.Spec.IstioControlPlane; ref != nil {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = instance.Namespace
+		}
+		for i := range istios {
+			if istios[i].Name == ref.Name && istios[i].Namespace == namespace {
+				return &istios[i], nil
+			}
+		}
+
+		return nil, &controlPlaneError{
+			reason:  devopsv1beta1.ConditionReasonControlPlaneNotFound,
+			message: fmt.Sprintf("referenced istio %s/%s not found", namespace, ref.Name),
+		}
+	}
+
+	var inNamespace, defaults []*devopsv1beta1.Istio
+	for i := range istios {
+		if istios[i].Namespace == instance.Namespace {
+			inNamespace = append(inNamespace, &istios[i])
+		}
+		if devopsv1beta1.IsDefaultControlPlane(&istios[i]) {
+			defaults = append(defaults, &istios[i])
+		}
+	}
+
+	var message string
+	switch {
+	case len(inNamespace) == 1:
+		return inNamespace[0], nil
+	case len(inNamespace) > 1:
+		message = fmt.Sprintf("found %d istio resources in namespace %s, the control plane has to be referenced", len(inNamespace), instance.Namespace)
+	case len(defaults) == 1:
+		return defaults[0], nil
+	case len(defaults) > 1:
+		message = fmt.Sprintf("found %d default control planes, the control plane has to be referenced", len(defaults))
+	case len(istios) == 1:
+		// the only control plane of the cluster is its default one
+		return &istios[0], nil
+	default:
+		message = fmt.Sprintf("could not find istio resource, found %d without a default control plane", len(istios))
+	}
+
+	return nil, &controlPlaneError{
+		reason:  devopsv1beta1.ConditionReasonControlPlaneNotFound,
+		message: message,
+	}
+}
+
+// meshGatewaysForIstio enqueues the mesh gateways belonging to the changed Istio or reconciled with it so far,
+// the ones that cannot find their control plane are enqueued as well so that they can be reported once it appears
+func meshGatewaysForIstio(c client.Client) handler.ToRequestsFunc {
+	return func(o handler.MapObject) []reconcile.Request {
+		var gws devopsv1beta1.MeshGatewayList
+		err := c.List(context.TODO(), &gws)
+		if err != nil {
+			log.Error(err, "could not list mesh gateways")
+			return nil
+		}
+		var configs devopsv1beta1.IstioList
+		err = c.List(context.TODO(), &configs)
+		if err != nil {
+			log.Error(err, "could not list istio resources")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range gws.Items {
+			gw := &gws.Items[i]
+			istio, err := ControlPlaneOf(gw, configs.Items)
+			belongs := err != nil || (istio.Name == o.Meta.GetName() && istio.Namespace == o.Meta.GetNamespace())
+			reconciled := gw.Status.IstioControlPlane != nil &&
+				gw.Status.IstioControlPlane.Name == o.Meta.GetName() && gw.Status.IstioControlPlane.Namespace == o.Meta.GetNamespace()
+			if !belongs && !reconciled {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+				Name:      gw.Name,
+				Namespace: gw.Namespace,
+			}})
+		}
+
+		return requests
+	}
+}
+
+// istioPredicate passes the changes of an Istio the gateways depend on: its spec, its rolled out version and
+// whether it is the default control plane
+var istioPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj := e.ObjectOld.(*devopsv1beta1.Istio)
+		newObj := e.ObjectNew.(*devopsv1beta1.Istio)
+		return oldObj.GetGeneration() != newObj.GetGeneration() ||
+			oldObj.Status.Version != newObj.Status.Version ||
+			devopsv1beta1.IsDefaultControlPlane(oldObj) != devopsv1beta1.IsDefaultControlPlane(newObj)
+	},
+}
+
+// meshGatewaysForIstiod enqueues the mesh gateways of the control plane of the changed istiod deployment
+func meshGatewaysForIstiod(c client.Client) handler.ToRequestsFunc {
+	forIstio := meshGatewaysForIstio(c)
+	return func(o handler.MapObject) []reconcile.Request {
+		owner := metav1.GetControllerOf(o.Meta)
+		if o.Meta.GetName() != istiod.DeploymentName || owner == nil || owner.Kind != "Istio" {
+			return nil
+		}
+
+		return forIstio(handler.MapObject{Meta: &metav1.ObjectMeta{
+			Name:      owner.Name,
+			Namespace: o.Meta.GetNamespace(),
+		}})
+	}
+}
+
+// istiodPredicate passes the istiod deployments becoming ready or unready
+var istiodPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return e.Meta.GetName() == istiod.DeploymentName
+	},
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj := e.ObjectOld.(*appsv1.Deployment)
+		newObj := e.ObjectNew.(*appsv1.Deployment)
+		return newObj.Name == istiod.DeploymentName &&
+			(oldObj.Status.AvailableReplicas > 0) != (newObj.Status.AvailableReplicas > 0)
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
+
+// nodePortMeshGateways enqueues the mesh gateways exposed on the node ports, their endpoints are the addresses
+// of the nodes
+func nodePortMeshGateways(c client.Client) handler.ToRequestsFunc {
+	return func(o handler.MapObject) []reconcile.Request {
+		var gws devopsv1beta1.MeshGatewayList
+		err := c.List(context.TODO(), &gws)
+		if err != nil {
+			log.Error(err, "could not list mesh gateways")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, gw := range gws.Items {
+			if gw.Spec.ServiceType != corev1.ServiceTypeNodePort {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+				Name:      gw.Name,
+				Namespace: gw.Namespace,
+			}})
+		}
+
+		return requests
+	}
+}
+
+// nodePredicate passes the nodes joining or leaving the cluster and the changes of their addresses
+var nodePredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldObj := e.ObjectOld.(*corev1.Node)
+		newObj := e.ObjectNew.(*corev1.Node)
+		return !reflect.DeepEqual(oldObj.Status.Addresses, newObj.Status.Addresses)
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return false
+	},
+}
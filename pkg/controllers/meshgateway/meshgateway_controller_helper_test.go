@@ -0,0 +1,182 @@
+package meshgateway
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+func testIstio(namespace, name string, isDefault bool, allowedNamespaces ...string) devopsv1beta1.Istio {
+	istio := devopsv1beta1.Istio{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	if isDefault {
+		istio.Annotations = map[string]string{devopsv1beta1.DefaultControlPlaneAnnotation: "true"}
+	}
+	istio.Spec.Gateways.AllowedNamespaces = allowedNamespaces
+
+	return istio
+}
+
+func testMeshGateway(namespace string, ref *devopsv1beta1.IstioControlPlaneReference, owner string) *devopsv1beta1.MeshGateway {
+	gw := &devopsv1beta1.MeshGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: namespace,
+		},
+	}
+	gw.Spec.IstioControlPlane = ref
+	if owner != "" {
+		gw.OwnerReferences = []metav1.OwnerReference{{
+			Kind:       "Istio",
+			Name:       owner,
+			Controller: utils.BoolPointer(true),
+		}}
+	}
+
+	return gw
+}
+
+func TestControlPlaneOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		gateway    *devopsv1beta1.MeshGateway
+		istios     []devopsv1beta1.Istio
+		want       string
+		wantReason string
+	}{
+		{
+			name:    "referenced control plane",
+			gateway: testMeshGateway("apps", &devopsv1beta1.IstioControlPlaneReference{Name: "mesh", Namespace: "istio-system"}, ""),
+			istios: []devopsv1beta1.Istio{
+				testIstio("istio-system", "mesh", false, "apps"),
+				testIstio("apps", "local", false),
+			},
+			want: "istio-system/mesh",
+		},
+		{
+			name:    "referenced control plane in the namespace of the gateway",
+			gateway: testMeshGateway("apps", &devopsv1beta1.IstioControlPlaneReference{Name: "local"}, ""),
+			istios: []devopsv1beta1.Istio{
+				testIstio("istio-system", "mesh", true),
+				testIstio("apps", "local", false),
+			},
+			want: "apps/local",
+		},
+		{
+			name:       "referenced control plane not found",
+			gateway:    testMeshGateway("apps", &devopsv1beta1.IstioControlPlaneReference{Name: "other"}, ""),
+			istios:     []devopsv1beta1.Istio{testIstio("apps", "local", false)},
+			wantReason: devopsv1beta1.ConditionReasonControlPlaneNotFound,
+		},
+		{
+			name:    "only control plane of the namespace",
+			gateway: testMeshGateway("apps", nil, ""),
+			istios: []devopsv1beta1.Istio{
+				testIstio("istio-system", "mesh", true),
+				testIstio("apps", "local", false),
+			},
+			want: "apps/local",
+		},
+		{
+			name:    "several control planes in the namespace",
+			gateway: testMeshGateway("apps", nil, ""),
+			istios: []devopsv1beta1.Istio{
+				testIstio("apps", "a", false),
+				testIstio("apps", "b", false),
+			},
+			wantReason: devopsv1beta1.ConditionReasonControlPlaneNotFound,
+		},
+		{
+			name:    "default control plane",
+			gateway: testMeshGateway("apps", nil, ""),
+			istios: []devopsv1beta1.Istio{
+				testIstio("istio-system", "mesh", true, "*"),
+				testIstio("other", "other", false),
+			},
+			want: "istio-system/mesh",
+		},
+		{
+			name:    "several default control planes",
+			gateway: testMeshGateway("apps", nil, ""),
+			istios: []devopsv1beta1.Istio{
+				testIstio("istio-system", "a", true, "*"),
+				testIstio("other", "b", true, "*"),
+			},
+			wantReason: devopsv1beta1.ConditionReasonControlPlaneNotFound,
+		},
+		{
+			name:    "only control plane of the cluster",
+			gateway: testMeshGateway("apps", nil, ""),
+			istios:  []devopsv1beta1.Istio{testIstio("istio-system", "mesh", false, "apps")},
+			want:    "istio-system/mesh",
+		},
+		{
+			name:    "no default control plane",
+			gateway: testMeshGateway("apps", nil, ""),
+			istios: []devopsv1beta1.Istio{
+				testIstio("istio-system", "a", false, "*"),
+				testIstio("other", "b", false, "*"),
+			},
+			wantReason: devopsv1beta1.ConditionReasonControlPlaneNotFound,
+		},
+		{
+			name:       "no control plane",
+			gateway:    testMeshGateway("apps", nil, ""),
+			wantReason: devopsv1beta1.ConditionReasonControlPlaneNotFound,
+		},
+		{
+			name:    "gateway owned by its control plane",
+			gateway: testMeshGateway("istio-system", nil, "mesh"),
+			istios:  []devopsv1beta1.Istio{testIstio("istio-system", "mesh", true)},
+			want:    "istio-system/mesh",
+		},
+		{
+			name:    "gateway owned by another control plane",
+			gateway: testMeshGateway("istio-system", &devopsv1beta1.IstioControlPlaneReference{Name: "canary"}, "mesh"),
+			istios: []devopsv1beta1.Istio{
+				testIstio("istio-system", "mesh", true),
+				testIstio("istio-system", "canary", false),
+			},
+			wantReason: devopsv1beta1.ConditionReasonControlPlaneMismatch,
+		},
+		{
+			name:       "namespace not allowed",
+			gateway:    testMeshGateway("apps", nil, ""),
+			istios:     []devopsv1beta1.Istio{testIstio("istio-system", "mesh", true, "other")},
+			wantReason: devopsv1beta1.ConditionReasonNamespaceNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			istio, err := ControlPlaneOf(tt.gateway, tt.istios)
+
+			var reason string
+			if err != nil {
+				cpErr, ok := err.(*controlPlaneError)
+				if !ok {
+					t.Fatalf("got error %v, want a control plane error", err)
+				}
+				reason = cpErr.reason
+			}
+			if reason != tt.wantReason {
+				t.Errorf("got error %v, want reason %q", err, tt.wantReason)
+			}
+
+			var got string
+			if istio != nil {
+				got = istio.Namespace + "/" + istio.Name
+			}
+			if got != tt.want {
+				t.Errorf("got control plane %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The symcn authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteistio
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/metrics"
+)
+
+// the remote cluster is not watched, so the remote side is resynced periodically
+const resyncPeriod = time.Duration(30) * time.Second
+
+// RemoteIstioReconciler reconciles a RemoteIstio object
+type RemoteIstioReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Mgr      manager.Manager
+	Scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+func Add(mgr manager.Manager) error {
+	reconciler := &RemoteIstioReconciler{
+		Client:   mgr.GetClient(),
+		Mgr:      mgr,
+		Log:      ctrl.Log.WithName("controllers").WithName("RemoteIstio"),
+		Scheme:   mgr.GetScheme(),
+		recorder: mgr.GetEventRecorderFor("remoteistio-controller"),
+	}
+
+	err := reconciler.SetupWithManager(mgr)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create RemoteIstio controller")
+	}
+	return nil
+}
+
+func (r *RemoteIstioReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&devopsv1beta1.RemoteIstio{}).
+		Watches(&source.Kind{Type: &devopsv1beta1.Istio{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.remoteIstiosForIstio),
+		}).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=devops.symcn.com,resources=remoteistios;remoteistios/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=devops.symcn.com,resources=remoteistios/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets;pods,verbs=get;list;watch
+
+func (r *RemoteIstioReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	logger := r.Log.WithValues("key", req.NamespacedName)
+
+	remoteConfig := &devopsv1beta1.RemoteIstio{}
+	err := r.Client.Get(ctx, req.NamespacedName, remoteConfig)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.Forget("RemoteIstio", req.Namespace, req.Name)
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "failed to get remote istio")
+		return reconcile.Result{}, err
+	}
+
+	// Set default values where not set
+	devopsv1beta1.SetRemoteIstioDefaults(remoteConfig)
+
+	if remoteConfig.Status.Status == "" {
+		err := r.updateStatus(remoteConfig, devopsv1beta1.Created, "", logger)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	config, err := r.getIstioForRemoteIstio(remoteConfig)
+	if err != nil {
+		logger.Error(err, "failed to get istio for remote istio")
+		r.updateStatus(remoteConfig, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+		return reconcile.Result{RequeueAfter: resyncPeriod}, nil
+	}
+
+	remoteClient, err := r.getRemoteClient(remoteConfig)
+	if err != nil {
+		logger.Error(err, "failed to create client for remote cluster")
+		r.recorder.Eventf(remoteConfig, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonReconcileFailed, "could not connect to the remote cluster: %v", err)
+		r.updateStatus(remoteConfig, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+		return reconcile.Result{RequeueAfter: resyncPeriod}, nil
+	}
+
+	// the changes made on the remote cluster are reported as events on the remote istio resource
+	remoteClient = k8sutils.WithEvents(remoteClient, r.recorder, remoteConfig)
+
+	components := Components(r.Client, remoteClient, config, remoteConfig)
+
+	for _, component := range components {
+		err = resources.ReconcileComponent(logger, component, &remoteConfig.Status.Conditions, remoteConfig.Generation)
+		if err != nil {
+			r.recorder.Eventf(remoteConfig, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonReconcileFailed, "component %s failed: %v", component.ConditionType, err)
+			updateErr := r.updateStatus(remoteConfig, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+			if updateErr != nil {
+				logger.Error(updateErr, "failed to update state")
+			}
+			return reconcile.Result{}, emperror.Wrap(err, "could not reconcile remote istio")
+		}
+	}
+
+	remoteConfig.Status.Version = config.Spec.Version
+
+	remoteConfig.Status.GatewayEndpoints, err = getRemoteGatewayEndpoints(remoteClient, config.Namespace)
+	remoteConfig.Status.GatewayAddress = k8sutils.EndpointAddresses(remoteConfig.Status.GatewayEndpoints)
+	if err != nil {
+		logger.Error(err, "remote ingress gateway address pending")
+		r.recorder.Event(remoteConfig, corev1.EventTypeWarning, devopsv1beta1.ConditionReasonAddressPending, err.Error())
+	}
+
+	err = r.updateStatus(remoteConfig, devopsv1beta1.Available, "", logger)
+	if err != nil {
+		return reconcile.Result{}, errors.WithStack(err)
+	}
+	logger.Info("reconcile finished")
+
+	return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+}
+
+func (r *RemoteIstioReconciler) updateStatus(config *devopsv1beta1.RemoteIstio, status devopsv1beta1.ConfigState, errorMessage string, logger logr.Logger) error {
+	typeMeta := config.TypeMeta
+	config.Status.Status = status
+	config.Status.ErrorMessage = errorMessage
+	config.Status.ObservedGeneration = config.Generation
+	err := r.Client.Status().Update(context.Background(), config)
+	if apierrors.IsNotFound(err) {
+		err = r.Client.Update(context.Background(), config)
+	}
+	if err != nil {
+		if !apierrors.IsConflict(err) {
+			return emperror.Wrapf(err, "could not update RemoteIstio state to '%s'", status)
+		}
+		var actualConfig devopsv1beta1.RemoteIstio
+		err := r.Client.Get(context.TODO(), types.NamespacedName{
+			Namespace: config.Namespace,
+			Name:      config.Name,
+		}, &actualConfig)
+		if err != nil {
+			return emperror.Wrap(err, "could not get config for updating status")
+		}
+		actualConfig.Status.Status = status
+		actualConfig.Status.GatewayAddress = config.Status.GatewayAddress
+		actualConfig.Status.GatewayEndpoints = config.Status.GatewayEndpoints
+		actualConfig.Status.ErrorMessage = errorMessage
+		actualConfig.Status.Version = config.Status.Version
+		actualConfig.Status.ObservedGeneration = config.Status.ObservedGeneration
+		actualConfig.Status.Conditions = config.Status.Conditions
+		err = r.Client.Status().Update(context.Background(), &actualConfig)
+		if apierrors.IsNotFound(err) {
+			err = r.Client.Update(context.Background(), &actualConfig)
+		}
+		if err != nil {
+			return emperror.Wrapf(err, "could not update RemoteIstio state to '%s'", status)
+		}
+	}
+	// update loses the typeMeta of the config that's used later when setting ownerrefs
+	config.TypeMeta = typeMeta
+	metrics.SetConfigState("RemoteIstio", config.Namespace, config.Name, status)
+	logger.Info("RemoteIstio state updated", "status", status)
+	return nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2020 The symcn authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteistio
+
+import (
+	"context"
+	"errors"
+
+	"github.com/goph/emperror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/autoinjection"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/base"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/ingressgateway"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/remote"
+	"github.com/symcn/mid-operator/pkg/k8sclient"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+)
+
+var (
+	errKubeconfigNotFound = errors.New("kubeconfig not found")
+	errIstioNotFound      = errors.New("exactly one istio resource is expected in the namespace")
+)
+
+// getRemoteClient builds a client for the remote cluster from the kubeconfig stored in the secret
+// named after the RemoteIstio, under the key of the same name
+func (r *RemoteIstioReconciler) getRemoteClient(remoteConfig *devopsv1beta1.RemoteIstio) (client.Client, error) {
+	var secret corev1.Secret
+	err := r.Client.Get(context.TODO(), client.ObjectKey{
+		Name:      remoteConfig.Name,
+		Namespace: remoteConfig.Namespace,
+	}, &secret)
+	if err != nil {
+		return nil, emperror.WrapWith(err, "could not get kubeconfig secret", "secret", remoteConfig.Name)
+	}
+
+	kubeconfig, ok := secret.Data[remoteConfig.Name]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, emperror.With(errKubeconfigNotFound, "secret", remoteConfig.Name, "key", remoteConfig.Name)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, emperror.Wrap(err, "could not parse kubeconfig")
+	}
+
+	c, err := client.New(restConfig, client.Options{Scheme: k8sclient.GetScheme()})
+	if err != nil {
+		return nil, emperror.Wrap(err, "could not create client for remote cluster")
+	}
+
+	return c, nil
+}
+
+// getIstioForRemoteIstio returns the Istio config of the primary cluster living in the namespace of the RemoteIstio,
+// overridden by the settings of the remote
+func (r *RemoteIstioReconciler) getIstioForRemoteIstio(remoteConfig *devopsv1beta1.RemoteIstio) (*devopsv1beta1.Istio, error) {
+	var configs devopsv1beta1.IstioList
+	err := r.Client.List(context.TODO(), &configs, client.InNamespace(remoteConfig.Namespace))
+	if err != nil {
+		return nil, emperror.Wrap(err, "could not list istio resources")
+	}
+
+	if len(configs.Items) != 1 {
+		return nil, emperror.With(errIstioNotFound, "namespace", remoteConfig.Namespace, "count", len(configs.Items))
+	}
+
+	return RemoteIstioConfig(&configs.Items[0], remoteConfig), nil
+}
+
+// RemoteIstioConfig returns a defaulted copy of the Istio config of the primary cluster, overridden by the settings
+// of the remote
+func RemoteIstioConfig(istio *devopsv1beta1.Istio, remoteConfig *devopsv1beta1.RemoteIstio) *devopsv1beta1.Istio {
+	config := istio.DeepCopy()
+	devopsv1beta1.SetDefaults(config)
+
+	config.Spec.ClusterName = remoteConfig.Name
+	config.Spec.IncludeIPRanges = remoteConfig.Spec.IncludeIPRanges
+	config.Spec.ExcludeIPRanges = remoteConfig.Spec.ExcludeIPRanges
+	config.Spec.AutoInjectionNamespaces = remoteConfig.Spec.AutoInjectionNamespaces
+	if remoteConfig.Spec.DefaultResources != nil {
+		config.Spec.DefaultResources = remoteConfig.Spec.DefaultResources
+	}
+	if remoteConfig.Spec.SidecarInjector.ReplicaCount != nil {
+		config.Spec.SidecarInjector.ReplicaCount = remoteConfig.Spec.SidecarInjector.ReplicaCount
+	}
+	if remoteConfig.Spec.Proxy.Image != "" {
+		config.Spec.Proxy.Image = remoteConfig.Spec.Proxy.Image
+	}
+	if remoteConfig.Spec.ProxyInit.Image != "" {
+		config.Spec.ProxyInit.Image = remoteConfig.Spec.ProxyInit.Image
+	}
+
+	return config
+}
+
+// Components returns the components installed into the remote cluster, remoteClient talks to the remote cluster
+// and localClient to the primary one
+func Components(localClient, remoteClient client.Client, config *devopsv1beta1.Istio, remoteConfig *devopsv1beta1.RemoteIstio) []resources.Component {
+	return []resources.Component{
+		{ConditionType: devopsv1beta1.ConditionTypeBase, Reconciler: base.New(remoteClient, nil, config, true)},
+		{ConditionType: devopsv1beta1.ConditionTypeRemote, Reconciler: remote.New(localClient, remoteClient, config, remoteConfig)},
+		{ConditionType: devopsv1beta1.ConditionTypeAutoInjection, Reconciler: autoinjection.New(remoteClient, remoteConfig, remoteConfig.Spec.AutoInjectionNamespaces, remoteConfig.Spec.AutoInjectionNamespaceSelector)},
+	}
+}
+
+// remoteIstiosForIstio enqueues every RemoteIstio in the namespace of the changed Istio
+func (r *RemoteIstioReconciler) remoteIstiosForIstio(o handler.MapObject) []reconcile.Request {
+	var remoteIstios devopsv1beta1.RemoteIstioList
+	err := r.Client.List(context.TODO(), &remoteIstios, client.InNamespace(o.Meta.GetNamespace()))
+	if err != nil {
+		r.Log.Error(err, "could not list remote istio resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(remoteIstios.Items))
+	for _, remoteIstio := range remoteIstios.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+			Name:      remoteIstio.Name,
+			Namespace: remoteIstio.Namespace,
+		}})
+	}
+
+	return requests
+}
+
+// getRemoteGatewayEndpoints returns the endpoints of the ingress gateway of the remote cluster, the nodes
+// of the cluster are only listed for a NodePort service
+func getRemoteGatewayEndpoints(c client.Client, namespace string) ([]devopsv1beta1.GatewayEndpoint, error) {
+	var service corev1.Service
+	err := c.Get(context.TODO(), client.ObjectKey{
+		Name:      ingressgateway.ResourceName,
+		Namespace: namespace,
+	}, &service)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, emperror.Wrap(err, "could not get remote ingress gateway service")
+	}
+
+	var nodes corev1.NodeList
+	if service.Spec.Type == corev1.ServiceTypeNodePort {
+		err = c.List(context.TODO(), &nodes)
+		if err != nil {
+			return nil, emperror.Wrap(err, "could not list remote nodes")
+		}
+	}
+
+	return k8sutils.GetServiceEndpoints(service, nodes.Items)
+}
@@ -0,0 +1,128 @@
+package autoinjection
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/istiod"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+)
+
+const (
+	componentName = "autoinjection"
+
+	// InjectionLabel enables the sidecar auto injection of the pods of the namespace
+	InjectionLabel = "istio-injection"
+	// ManagedLabel marks the namespaces labeled by the operator with the <namespace>.<name> of the resource that
+	// labeled them, the injection label is only ever removed by that resource
+	ManagedLabel = "devops.symcn.com/auto-injection"
+)
+
+// Reconciler labels the namespaces listed by name or matching the selector with sidecar auto injection enabled
+type Reconciler struct {
+	client   client.Client
+	owner    string
+	names    []string
+	selector *metav1.LabelSelector
+}
+
+// New returns the auto injection reconciler of the owner resource, which is recorded on the namespaces it labels
+func New(client client.Client, owner metav1.Object, names []string, selector *metav1.LabelSelector) *Reconciler {
+	return &Reconciler{
+		client:   client,
+		owner:    Owner(owner),
+		names:    names,
+		selector: selector,
+	}
+}
+
+// Owner returns the value of the managed label set on the namespaces labeled for the resource
+func Owner(o metav1.Object) string {
+	return o.GetNamespace() + "." + o.GetName()
+}
+
+// Selects returns whether the namespace is listed by name or matches the selector
+func Selects(names []string, selector *metav1.LabelSelector, ns metav1.Object) (bool, error) {
+	for _, name := range names {
+		if name == ns.GetName() {
+			return true, nil
+		}
+	}
+	if selector == nil {
+		return false, nil
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, emperror.Wrap(err, "invalid auto injection namespace selector")
+	}
+	return s.Matches(labels.Set(ns.GetLabels())), nil
+}
+
+func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, false)
+}
+
+// Cleanup removes the injection label from the namespaces labeled by the operator
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, true)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, teardown bool) error {
+	log = log.WithValues("component", componentName)
+
+	log.Info("Reconciling")
+
+	var namespaces corev1.NamespaceList
+	err := r.client.List(context.TODO(), &namespaces)
+	if err != nil {
+		return emperror.Wrap(err, "could not list namespaces")
+	}
+
+	for _, ns := range namespaces.Items {
+		if !ns.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		selected, err := Selects(r.names, r.selector, &ns)
+		if err != nil {
+			return err
+		}
+		managed := ns.Labels[ManagedLabel] == r.owner
+		desired := !teardown && selected
+		switch {
+		case desired && ns.Labels[InjectionLabel] != "enabled":
+			if owner := ns.Labels[ManagedLabel]; owner != "" && owner != r.owner {
+				// the managed label records another control plane, only that one changes the namespace
+				log.Info("namespace is managed by another control plane, skipping", "namespace", ns.Name, "owner", owner)
+				continue
+			}
+			if ns.Labels[istiod.RevisionLabel] != "" {
+				// the injection label would take the namespace over from its istiod revision
+				log.Info("namespace is injected by an istiod revision, skipping", "namespace", ns.Name, "revision", ns.Labels[istiod.RevisionLabel])
+				continue
+			}
+			err = k8sutils.ReconcileNamespaceLabelsIgnoreNotFound(log, r.client, ns.Name, map[string]string{
+				InjectionLabel: "enabled",
+				ManagedLabel:   r.owner,
+			}, nil)
+		case !desired && managed:
+			err = k8sutils.ReconcileNamespaceLabelsIgnoreNotFound(log, r.client, ns.Name, nil, []string{
+				InjectionLabel,
+				ManagedLabel,
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info("Reconciled")
+
+	return nil
+}
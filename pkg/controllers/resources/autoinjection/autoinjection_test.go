@@ -0,0 +1,64 @@
+package autoinjection
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSelects(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "foo",
+			Labels: map[string]string{"team": "a"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		names    []string
+		selector *metav1.LabelSelector
+		want     bool
+		wantErr  bool
+	}{
+		{name: "neither names nor selector"},
+		{name: "listed by name", names: []string{"bar", "foo"}, want: true},
+		{name: "not listed by name", names: []string{"bar"}},
+		{
+			name:     "matching selector",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			want:     true,
+		},
+		{
+			name:     "not matching selector",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+		},
+		{name: "empty selector", selector: &metav1.LabelSelector{}, want: true},
+		{
+			name:     "listed by name but not matching selector",
+			names:    []string{"foo"},
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+			want:     true,
+		},
+		{
+			name: "invalid selector",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "team", Operator: "Unknown"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Selects(tt.names, tt.selector, ns)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
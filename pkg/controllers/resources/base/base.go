@@ -3,6 +3,8 @@ package base
 import (
 	"github.com/go-logr/logr"
 	"github.com/goph/emperror"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
@@ -23,24 +25,45 @@ var istioReaderLabel = map[string]string{
 
 type Reconciler struct {
 	resources.Reconciler
-	remote bool
+	dynamic      dynamic.Interface
+	remote       bool
+	remoteIstios []devopsv1beta1.RemoteIstio
 }
 
-func New(client client.Client, config *devopsv1beta1.Istio, isRemote bool) *Reconciler {
+func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio, isRemote bool) *Reconciler {
 	return &Reconciler{
 		Reconciler: resources.Reconciler{
 			Client: client,
 			Config: config,
 		},
-		remote: isRemote,
+		dynamic: dc,
+		remote:  isRemote,
 	}
 }
 
 func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStatePresent)
+}
+
+// Cleanup removes the resources of the component
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStateAbsent)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, desiredState k8sutils.DesiredState) error {
 	log = log.WithValues("component", componentName)
 
 	log.Info("Reconciling")
 
+	// remote clusters are only known by the primary one
+	if !r.remote {
+		remoteIstios, err := resources.GetRemoteIstios(r.Client, r.Config)
+		if err != nil {
+			return err
+		}
+		r.remoteIstios = remoteIstios
+	}
+
 	for _, res := range []resources.Resource{
 		r.serviceAccount,
 		r.clusterRole,
@@ -48,12 +71,29 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 		r.configMap,
 	} {
 		o := res()
-		err := k8sutils.Reconcile(log, r.Client, o, k8sutils.DesiredStatePresent)
+		if r.remote {
+			// the owner of the resources only exists on the primary cluster
+			if objectMeta, err := meta.Accessor(o); err == nil {
+				objectMeta.SetOwnerReferences(nil)
+			}
+		}
+		err := k8sutils.Reconcile(log, r.Client, o, desiredState)
 		if err != nil {
 			return emperror.WrapWith(err, "failed to reconcile resource", "resource", o.GetObjectKind().GroupVersionKind())
 		}
 	}
 
+	// the authentication policies are served by the control plane of the primary cluster
+	if !r.remote {
+		namespaces, err := r.reconcileMeshPolicy(log, desiredState)
+		if err != nil {
+			return emperror.Wrap(err, "failed to reconcile mesh policy")
+		}
+		if desiredState == k8sutils.DesiredStatePresent {
+			r.Config.Status.MTLS = r.Config.Spec.MeshPolicy.MTLSStatus(namespaces)
+		}
+	}
+
 	log.Info("Reconciled")
 
 	return nil
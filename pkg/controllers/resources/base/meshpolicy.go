@@ -0,0 +1,119 @@
+package base
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+const peerAuthenticationName = "default"
+
+var peerAuthenticationGvr = schema.GroupVersionResource{
+	Group:    "security.istio.io",
+	Version:  "v1beta1",
+	Resource: "peerauthentications",
+}
+
+// reconcileMeshPolicy applies the mesh-wide mTLS policy in the root namespace and the overrides of the namespaces,
+// it returns the namespaces whose override is applied, the missing namespaces are skipped
+func (r *Reconciler) reconcileMeshPolicy(log logr.Logger, desiredState k8sutils.DesiredState) (map[string]bool, error) {
+	err := r.Config.Spec.MeshPolicy.ValidateNamespaces(r.Config.Namespace)
+	if err != nil && desiredState == k8sutils.DesiredStatePresent {
+		return nil, err
+	}
+
+	o := r.peerAuthentication(r.Config.Namespace, r.Config.Spec.MeshPolicy.MTLSMode)
+	err = o.Reconcile(log, r.dynamic, desiredState)
+	if err != nil {
+		return nil, emperror.WrapWith(err, "failed to reconcile dynamic resource", "resource", o.Gvr)
+	}
+
+	namespaces := make(map[string]bool)
+	if desiredState == k8sutils.DesiredStatePresent {
+		for _, override := range r.Config.Spec.MeshPolicy.Namespaces {
+			var namespace corev1.Namespace
+			err := r.Client.Get(context.TODO(), client.ObjectKey{Name: override.Namespace}, &namespace)
+			if apierrors.IsNotFound(err) {
+				log.Info("namespace of the mtls override not found", "namespace", override.Namespace)
+				continue
+			}
+			if err != nil {
+				return nil, emperror.WrapWith(err, "could not get namespace", "namespace", override.Namespace)
+			}
+
+			o := r.peerAuthentication(override.Namespace, override.MTLSMode)
+			// owner references cannot cross namespaces, the override is tracked through its owner labels
+			o.Owner = nil
+			err = o.Reconcile(log, r.dynamic, desiredState)
+			if err != nil {
+				return nil, emperror.WrapWith(err, "failed to reconcile dynamic resource", "resource", o.Gvr, "namespace", override.Namespace)
+			}
+			namespaces[override.Namespace] = true
+		}
+	}
+
+	err = r.removeStaleMTLSOverrides(log, namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	return namespaces, nil
+}
+
+// removeStaleMTLSOverrides deletes the policies of the namespaces no longer overridden
+func (r *Reconciler) removeStaleMTLSOverrides(log logr.Logger, namespaces map[string]bool) error {
+	list, err := r.dynamic.Resource(peerAuthenticationGvr).Namespace(metav1.NamespaceAll).List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(r.peerAuthenticationLabels()).String(),
+	})
+	if err != nil {
+		return emperror.Wrap(err, "could not list peer authentications")
+	}
+
+	for _, item := range list.Items {
+		if item.GetNamespace() == r.Config.Namespace || namespaces[item.GetNamespace()] {
+			continue
+		}
+
+		err := r.dynamic.Resource(peerAuthenticationGvr).Namespace(item.GetNamespace()).Delete(item.GetName(), &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return emperror.WrapWith(err, "could not delete peer authentication", "namespace", item.GetNamespace())
+		}
+		k8sutils.RecordResourceEvent(r.dynamic, k8sutils.EventReasonDeleted, item.GetKind(), item.GetNamespace(), item.GetName())
+		log.Info("mtls override removed", "namespace", item.GetNamespace())
+	}
+
+	return nil
+}
+
+func (r *Reconciler) peerAuthenticationLabels() map[string]string {
+	return utils.MergeStringMaps(map[string]string{
+		utils.CreatedByLabel: utils.CreatedBy,
+	}, utils.OwnerLabels("Istio", r.Config.Name, r.Config.Namespace))
+}
+
+func (r *Reconciler) peerAuthentication(namespace string, mode devopsv1beta1.MTLSMode) *k8sutils.DynamicObject {
+	return &k8sutils.DynamicObject{
+		Gvr:       peerAuthenticationGvr,
+		Kind:      "PeerAuthentication",
+		Name:      peerAuthenticationName,
+		Namespace: namespace,
+		Labels:    r.peerAuthenticationLabels(),
+		Spec: map[string]interface{}{
+			"mtls": map[string]interface{}{
+				"mode": mode.PeerAuthenticationMode(),
+			},
+		},
+		Owner: r.Config,
+	}
+}
@@ -48,11 +48,20 @@ func New(client client.Client, config *devopsv1beta1.Istio) *Reconciler {
 }
 
 func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, false)
+}
+
+// Cleanup removes the resources of the component
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, true)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, teardown bool) error {
 	log = log.WithValues("component", componentName)
 
 	desiredState := k8sutils.DesiredStatePresent
 	desiredStateRepair := k8sutils.DesiredStatePresent
-	if !utils.PointerToBool(r.Config.Spec.SidecarInjector.InitCNIConfiguration.Enabled) {
+	if !utils.PointerToBool(r.Config.Spec.SidecarInjector.InitCNIConfiguration.Enabled) || teardown {
 		desiredState = k8sutils.DesiredStateAbsent
 		desiredStateRepair = k8sutils.DesiredStateAbsent
 	}
@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+)
+
+// ComponentError is the error of a failed component
+type ComponentError struct {
+	ConditionType devopsv1beta1.ConditionType
+	Err           error
+}
+
+func (e ComponentError) Error() string {
+	return fmt.Sprintf("component '%s': %v", e.ConditionType, e.Err)
+}
+
+// DependencyError is the error of a component that was not run because components it depends on failed
+type DependencyError struct {
+	Failed []devopsv1beta1.ConditionType
+}
+
+func (e DependencyError) Error() string {
+	failed := make([]string, 0, len(e.Failed))
+	for _, conditionType := range e.Failed {
+		failed = append(failed, string(conditionType))
+	}
+
+	return "depends on failed components " + strings.Join(failed, ", ")
+}
+
+// ComponentErrors are the errors of the failed components, in the order of the components
+type ComponentErrors []ComponentError
+
+func (e ComponentErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return "could not reconcile " + strings.Join(messages, "; ")
+}
+
+type componentResult struct {
+	index int
+	err   error
+}
+
+// ReconcileComponents runs the components whose dependencies are reconciled concurrently, up to threadiness of them
+// at the same time and at least one, and reports the outcome of each into its condition. A failing component only
+// stops the components depending on it, they are reported as failed with a DependencyError without being run.
+// A dependency outside of the given components is satisfied when its condition is true. The errors of the failed
+// components are returned, nil when all of them succeeded.
+func ReconcileComponents(log logr.Logger, components []Component, conditions *[]devopsv1beta1.Condition, generation int64, threadiness int) ComponentErrors {
+	if threadiness < 1 {
+		threadiness = 1
+	}
+	errs := make([]error, len(components))
+	finished := make([]bool, len(components))
+	started := make([]bool, len(components))
+	index := make(map[devopsv1beta1.ConditionType]int, len(components))
+	for i, component := range components {
+		index[component.ConditionType] = i
+	}
+
+	// ready tells whether the dependencies of the component succeeded, it returns the failed ones otherwise
+	ready := func(component Component) (bool, []devopsv1beta1.ConditionType) {
+		var failed []devopsv1beta1.ConditionType
+		for _, dependency := range component.DependsOn {
+			i, ok := index[dependency]
+			switch {
+			case !ok:
+				if !devopsv1beta1.IsConditionTrue(*conditions, dependency) {
+					failed = append(failed, dependency)
+				}
+			case !finished[i]:
+				return false, nil
+			case errs[i] != nil:
+				failed = append(failed, dependency)
+			}
+		}
+
+		return len(failed) == 0, failed
+	}
+
+	skip := func(i int, err error) {
+		errs[i] = err
+		devopsv1beta1.SetCondition(conditions, devopsv1beta1.Condition{
+			Type:               components[i].ConditionType,
+			Status:             corev1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             devopsv1beta1.ConditionReasonDependencyFailed,
+			Message:            err.Error(),
+		})
+	}
+
+	results := make(chan componentResult)
+	running := 0
+	for {
+		// skipping a component may settle the components depending on it listed before it
+		for changed := true; changed; {
+			changed = false
+			for i, component := range components {
+				if started[i] || running >= threadiness {
+					continue
+				}
+				ok, failed := ready(component)
+				if len(failed) > 0 {
+					started[i], finished[i], changed = true, true, true
+					skip(i, DependencyError{Failed: failed})
+					continue
+				}
+				if !ok {
+					continue
+				}
+				started[i], changed = true, true
+				running++
+				go func(i int, component Component) {
+					results <- componentResult{index: i, err: reconcileComponent(log, component)}
+				}(i, component)
+			}
+		}
+
+		if running == 0 {
+			break
+		}
+		result := <-results
+		running--
+		finished[result.index] = true
+		errs[result.index] = result.err
+		devopsv1beta1.SetCondition(conditions, componentCondition(components[result.index], result.err, generation))
+	}
+
+	var componentErrors ComponentErrors
+	for i, component := range components {
+		if !started[i] {
+			// the dependencies are circular
+			skip(i, fmt.Errorf("circular dependencies %v", component.DependsOn))
+		}
+		if errs[i] != nil {
+			componentErrors = append(componentErrors, ComponentError{ConditionType: component.ConditionType, Err: errs[i]})
+		}
+	}
+
+	return componentErrors
+}
@@ -0,0 +1,258 @@
+package resources
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+)
+
+const (
+	conditionA        devopsv1beta1.ConditionType = "A"
+	conditionB        devopsv1beta1.ConditionType = "B"
+	conditionC        devopsv1beta1.ConditionType = "C"
+	conditionExternal devopsv1beta1.ConditionType = "External"
+)
+
+// fakeRuns records the order the fake components ran in and the most of them running at the same time
+type fakeRuns struct {
+	sync.Mutex
+	order      []devopsv1beta1.ConditionType
+	running    int
+	maxRunning int
+}
+
+type fakeComponent struct {
+	conditionType devopsv1beta1.ConditionType
+	err           error
+	runs          *fakeRuns
+}
+
+func (c *fakeComponent) Reconcile(log logr.Logger) error {
+	c.runs.Lock()
+	c.runs.running++
+	if c.runs.running > c.runs.maxRunning {
+		c.runs.maxRunning = c.runs.running
+	}
+	c.runs.Unlock()
+
+	// leaves the other ready components the time to start
+	time.Sleep(10 * time.Millisecond)
+
+	c.runs.Lock()
+	c.runs.running--
+	c.runs.order = append(c.runs.order, c.conditionType)
+	c.runs.Unlock()
+
+	return c.err
+}
+
+type fakeSpec struct {
+	conditionType devopsv1beta1.ConditionType
+	err           error
+	dependsOn     []devopsv1beta1.ConditionType
+}
+
+func TestReconcileComponents(t *testing.T) {
+	failure := errors.New("failure")
+
+	tests := []struct {
+		name        string
+		components  []fakeSpec
+		conditions  []devopsv1beta1.Condition
+		threadiness int
+		// wantOrder is the order the components ran in, nil when the order does not matter
+		wantOrder      []devopsv1beta1.ConditionType
+		wantRun        int
+		wantMaxRunning int
+		wantFailed     []devopsv1beta1.ConditionType
+		wantSkipped    []DependencyError
+		wantStatus     map[devopsv1beta1.ConditionType]corev1.ConditionStatus
+		wantReason     map[devopsv1beta1.ConditionType]string
+	}{
+		{
+			name: "independent components run concurrently",
+			components: []fakeSpec{
+				{conditionType: conditionA},
+				{conditionType: conditionB},
+				{conditionType: conditionC},
+			},
+			threadiness:    3,
+			wantRun:        3,
+			wantMaxRunning: 3,
+			wantStatus: map[devopsv1beta1.ConditionType]corev1.ConditionStatus{
+				conditionA: corev1.ConditionTrue,
+				conditionB: corev1.ConditionTrue,
+				conditionC: corev1.ConditionTrue,
+			},
+		},
+		{
+			name: "threadiness bounds the running components",
+			components: []fakeSpec{
+				{conditionType: conditionA},
+				{conditionType: conditionB},
+				{conditionType: conditionC},
+			},
+			threadiness:    2,
+			wantRun:        3,
+			wantMaxRunning: 2,
+		},
+		{
+			name: "threadiness below one runs one component at a time",
+			components: []fakeSpec{
+				{conditionType: conditionA},
+				{conditionType: conditionB},
+			},
+			threadiness:    0,
+			wantRun:        2,
+			wantMaxRunning: 1,
+		},
+		{
+			name: "dependent components run after their dependencies",
+			components: []fakeSpec{
+				{conditionType: conditionC, dependsOn: []devopsv1beta1.ConditionType{conditionB}},
+				{conditionType: conditionB, dependsOn: []devopsv1beta1.ConditionType{conditionA}},
+				{conditionType: conditionA},
+			},
+			threadiness:    3,
+			wantOrder:      []devopsv1beta1.ConditionType{conditionA, conditionB, conditionC},
+			wantRun:        3,
+			wantMaxRunning: 1,
+		},
+		{
+			name: "failed dependency skips the dependent components only",
+			components: []fakeSpec{
+				{conditionType: conditionA, err: failure},
+				{conditionType: conditionB, dependsOn: []devopsv1beta1.ConditionType{conditionA}},
+				{conditionType: conditionC},
+			},
+			threadiness:    2,
+			wantRun:        2,
+			wantMaxRunning: 2,
+			wantFailed:     []devopsv1beta1.ConditionType{conditionA, conditionB},
+			wantSkipped:    []DependencyError{{Failed: []devopsv1beta1.ConditionType{conditionA}}},
+			wantStatus: map[devopsv1beta1.ConditionType]corev1.ConditionStatus{
+				conditionA: corev1.ConditionFalse,
+				conditionB: corev1.ConditionFalse,
+				conditionC: corev1.ConditionTrue,
+			},
+			wantReason: map[devopsv1beta1.ConditionType]string{
+				conditionA: devopsv1beta1.ConditionReasonReconcileFailed,
+				conditionB: devopsv1beta1.ConditionReasonDependencyFailed,
+			},
+		},
+		{
+			name: "external dependency with a true condition is satisfied",
+			components: []fakeSpec{
+				{conditionType: conditionA, dependsOn: []devopsv1beta1.ConditionType{conditionExternal}},
+			},
+			conditions: []devopsv1beta1.Condition{
+				{Type: conditionExternal, Status: corev1.ConditionTrue},
+			},
+			threadiness:    1,
+			wantRun:        1,
+			wantMaxRunning: 1,
+			wantStatus: map[devopsv1beta1.ConditionType]corev1.ConditionStatus{
+				conditionA: corev1.ConditionTrue,
+			},
+		},
+		{
+			name: "external dependency without a true condition fails",
+			components: []fakeSpec{
+				{conditionType: conditionA, dependsOn: []devopsv1beta1.ConditionType{conditionExternal}},
+			},
+			conditions: []devopsv1beta1.Condition{
+				{Type: conditionExternal, Status: corev1.ConditionFalse},
+			},
+			threadiness: 1,
+			wantFailed:  []devopsv1beta1.ConditionType{conditionA},
+			wantSkipped: []DependencyError{{Failed: []devopsv1beta1.ConditionType{conditionExternal}}},
+			wantReason: map[devopsv1beta1.ConditionType]string{
+				conditionA: devopsv1beta1.ConditionReasonDependencyFailed,
+			},
+		},
+		{
+			name: "circular dependencies are not run",
+			components: []fakeSpec{
+				{conditionType: conditionA, dependsOn: []devopsv1beta1.ConditionType{conditionB}},
+				{conditionType: conditionB, dependsOn: []devopsv1beta1.ConditionType{conditionA}},
+				{conditionType: conditionC},
+			},
+			threadiness:    2,
+			wantRun:        1,
+			wantMaxRunning: 1,
+			wantFailed:     []devopsv1beta1.ConditionType{conditionA, conditionB},
+			wantStatus: map[devopsv1beta1.ConditionType]corev1.ConditionStatus{
+				conditionA: corev1.ConditionFalse,
+				conditionB: corev1.ConditionFalse,
+				conditionC: corev1.ConditionTrue,
+			},
+			wantReason: map[devopsv1beta1.ConditionType]string{
+				conditionA: devopsv1beta1.ConditionReasonDependencyFailed,
+				conditionB: devopsv1beta1.ConditionReasonDependencyFailed,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs := &fakeRuns{}
+			components := make([]Component, 0, len(tt.components))
+			for _, spec := range tt.components {
+				components = append(components, Component{
+					ConditionType: spec.conditionType,
+					Reconciler:    &fakeComponent{conditionType: spec.conditionType, err: spec.err, runs: runs},
+					DependsOn:     spec.dependsOn,
+				})
+			}
+			conditions := tt.conditions
+
+			errs := ReconcileComponents(ctrl.Log, components, &conditions, 1, tt.threadiness)
+
+			if len(runs.order) != tt.wantRun {
+				t.Errorf("ran %v, want %d components", runs.order, tt.wantRun)
+			}
+			if tt.wantOrder != nil && !reflect.DeepEqual(runs.order, tt.wantOrder) {
+				t.Errorf("ran %v, want %v", runs.order, tt.wantOrder)
+			}
+			if runs.maxRunning != tt.wantMaxRunning {
+				t.Errorf("ran %d components at the same time, want %d", runs.maxRunning, tt.wantMaxRunning)
+			}
+
+			var failed []devopsv1beta1.ConditionType
+			var skipped []DependencyError
+			for _, err := range errs {
+				failed = append(failed, err.ConditionType)
+				if depErr, ok := err.Err.(DependencyError); ok {
+					skipped = append(skipped, depErr)
+				}
+			}
+			if !reflect.DeepEqual(failed, tt.wantFailed) {
+				t.Errorf("failed %v, want %v", failed, tt.wantFailed)
+			}
+			if !reflect.DeepEqual(skipped, tt.wantSkipped) {
+				t.Errorf("skipped %v, want %v", skipped, tt.wantSkipped)
+			}
+
+			for conditionType, status := range tt.wantStatus {
+				condition := devopsv1beta1.FindCondition(conditions, conditionType)
+				if condition == nil || condition.Status != status {
+					t.Errorf("condition %s is %v, want status %s", conditionType, condition, status)
+				}
+			}
+			for conditionType, reason := range tt.wantReason {
+				condition := devopsv1beta1.FindCondition(conditions, conditionType)
+				if condition == nil || condition.Reason != reason {
+					t.Errorf("condition %s is %v, want reason %s", conditionType, condition, reason)
+				}
+			}
+		})
+	}
+}
@@ -41,13 +41,22 @@ func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio
 }
 
 func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, false)
+}
+
+// Cleanup removes the mesh gateway and the Istio resources of the component
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, true)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, teardown bool) error {
 	log = log.WithValues("component", componentName)
 
 	log.Info("Reconciling")
 
 	var desiredState k8sutils.DesiredState
 
-	if utils.PointerToBool(r.Config.Spec.Gateways.Enabled) && utils.PointerToBool(r.Config.Spec.Gateways.EgressConfig.Enabled) {
+	if utils.PointerToBool(r.Config.Spec.Gateways.Enabled) && utils.PointerToBool(r.Config.Spec.Gateways.EgressConfig.Enabled) && !teardown {
 		desiredState = k8sutils.DesiredStatePresent
 	} else {
 		desiredState = k8sutils.DesiredStateAbsent
@@ -57,11 +66,18 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 		MeshGatewayConfiguration: r.Config.Spec.Gateways.EgressConfig.MeshGatewayConfiguration,
 		Ports:                    r.Config.Spec.Gateways.EgressConfig.Ports,
 		Type:                     devopsv1beta1.GatewayTypeEgress,
+		// the gateway belongs to the control plane creating it
+		IstioControlPlane: &devopsv1beta1.IstioControlPlaneReference{
+			Name:      r.Config.Name,
+			Namespace: r.Config.Namespace,
+		},
 	}
 	spec.Labels = r.labels()
 	object := &devopsv1beta1.MeshGateway{
-		ObjectMeta: templates.ObjectMeta(resourceName, spec.Labels, r.Config),
-		Spec:       spec,
+		ObjectMeta: templates.ObjectMetaWithAnnotations(resourceName, spec.Labels, map[string]string{
+			devopsv1beta1.IstioVersionAnnotation: string(r.Config.Status.Version),
+		}, r.Config),
+		Spec: spec,
 	}
 
 	err := k8sutils.Reconcile(log, r.Client, object, desiredState)
@@ -70,7 +86,7 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 	}
 
 	var multimeshEgressGatewayDesiredState k8sutils.DesiredState
-	if utils.PointerToBool(r.Config.Spec.MultiMesh) && utils.PointerToBool(r.Config.Spec.Gateways.EgressConfig.Enabled) {
+	if utils.PointerToBool(r.Config.Spec.MultiMesh) && utils.PointerToBool(r.Config.Spec.Gateways.EgressConfig.Enabled) && !teardown {
 		multimeshEgressGatewayDesiredState = k8sutils.DesiredStatePresent
 	} else {
 		multimeshEgressGatewayDesiredState = k8sutils.DesiredStateAbsent
@@ -29,6 +29,20 @@ func (r *Reconciler) clusterRoleBindingName() string {
 	return fmt.Sprintf("%s-cluster-role-binding", r.gw.Name)
 }
 
+func (r *Reconciler) networkingRoleName() string {
+	return fmt.Sprintf("%s-role", r.gw.Name)
+}
+
+func (r *Reconciler) networkingRoleBindingName() string {
+	return fmt.Sprintf("%s-role-binding", r.gw.Name)
+}
+
+// inControlPlaneNamespace returns whether the gateway lives along with its control plane, the gateways of the
+// application namespaces get namespaced RBAC instead of cluster scoped one
+func (r *Reconciler) inControlPlaneNamespace() bool {
+	return r.gw.Namespace == r.Config.Namespace
+}
+
 func (r *Reconciler) roleName() string {
 	return fmt.Sprintf("%s-role-sds", r.gw.Name)
 }
@@ -117,7 +117,7 @@ func (r *Reconciler) deployment() runtime.Object {
 		Spec: appsv1.DeploymentSpec{
 			Replicas: utils.IntPointer(k8sutils.GetHPAReplicaCountOrDefault(r.Client, types.NamespacedName{
 				Name:      r.hpaName(),
-				Namespace: r.Config.Namespace,
+				Namespace: r.gw.Namespace,
 			}, *r.gw.Spec.ReplicaCount)),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: r.labels(),
@@ -233,7 +233,7 @@ func (r *Reconciler) envVars() []corev1.EnvVar {
 		},
 		{
 			Name:  "ISTIO_META_OWNER",
-			Value: fmt.Sprintf("kubernetes://apis/apps/v1/namespaces/%s/deployments/%s", r.Config.Namespace, r.gatewayName()),
+			Value: fmt.Sprintf("kubernetes://apis/apps/v1/namespaces/%s/deployments/%s", r.gw.Namespace, r.gatewayName()),
 		},
 	}
 
@@ -6,13 +6,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 	"github.com/symcn/mid-operator/pkg/k8sutils"
 )
 
-func (r *Reconciler) GetGatewayAddress() ([]string, error) {
+// GetGatewayEndpoints returns the endpoints the gateway service is reachable at from outside of the cluster
+func (r *Reconciler) GetGatewayEndpoints() ([]devopsv1beta1.GatewayEndpoint, error) {
 	var service corev1.Service
-	var ips []string
-
 	err := r.Get(context.Background(), types.NamespacedName{
 		Name:      r.gatewayName(),
 		Namespace: r.gw.Namespace,
@@ -21,10 +21,13 @@ func (r *Reconciler) GetGatewayAddress() ([]string, error) {
 		return nil, err
 	}
 
-	ips, err = k8sutils.GetServiceEndpointIPs(service)
-	if err != nil {
-		return nil, err
+	var nodes corev1.NodeList
+	if service.Spec.Type == corev1.ServiceTypeNodePort {
+		err = r.List(context.Background(), &nodes)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return ips, nil
+	return k8sutils.GetServiceEndpoints(service, nodes.Items)
 }
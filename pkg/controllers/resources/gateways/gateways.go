@@ -1,12 +1,8 @@
 package gateways
 
 import (
-	"context"
-
 	"github.com/go-logr/logr"
 	"github.com/goph/emperror"
-	"github.com/pkg/errors"
-	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,7 +10,6 @@ import (
 	"github.com/symcn/mid-operator/pkg/controllers/resources"
 	"github.com/symcn/mid-operator/pkg/k8sutils"
 	"github.com/symcn/mid-operator/pkg/utils"
-	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
@@ -39,42 +34,66 @@ func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio
 	}
 }
 
+// Reconcile installs the resources of the mesh gateway, istiod is expected to be running already
 func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, false)
+}
+
+// Cleanup removes the resources of the mesh gateway
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, true)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, teardown bool) error {
 	log = log.WithValues("component", componentName)
 
 	log.Info("Reconciling")
 
-	err := r.waitForIstiod()
-	if err != nil {
-		return err
+	desiredState := k8sutils.DesiredStatePresent
+	if teardown {
+		desiredState = k8sutils.DesiredStateAbsent
 	}
 
 	pdbDesiredState := k8sutils.DesiredStateAbsent
-	if utils.PointerToBool(r.Config.Spec.DefaultPodDisruptionBudget.Enabled) {
+	if utils.PointerToBool(r.Config.Spec.DefaultPodDisruptionBudget.Enabled) && !teardown {
 		pdbDesiredState = k8sutils.DesiredStatePresent
 	}
 
 	sdsDesiredState := k8sutils.DesiredStateAbsent
-	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) {
+	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) && !teardown {
 		sdsDesiredState = k8sutils.DesiredStatePresent
 	}
 
 	hpaDesiredState := k8sutils.DesiredStateAbsent
-	if r.gw.Spec.MinReplicas != nil && r.gw.Spec.MaxReplicas != nil && *r.gw.Spec.MinReplicas > 1 && *r.gw.Spec.MinReplicas != *r.gw.Spec.MaxReplicas {
+	if r.gw.Spec.MinReplicas != nil && r.gw.Spec.MaxReplicas != nil && *r.gw.Spec.MinReplicas > 1 && *r.gw.Spec.MinReplicas != *r.gw.Spec.MaxReplicas && !teardown {
 		hpaDesiredState = k8sutils.DesiredStatePresent
 	}
 
-	for _, res := range []resources.ResourceWithDesiredState{
-		{Resource: r.serviceAccount, DesiredState: k8sutils.DesiredStatePresent},
-		{Resource: r.clusterRole, DesiredState: k8sutils.DesiredStatePresent},
-		{Resource: r.clusterRoleBinding, DesiredState: k8sutils.DesiredStatePresent},
-		{Resource: r.deployment, DesiredState: k8sutils.DesiredStatePresent},
-		{Resource: r.service, DesiredState: k8sutils.DesiredStatePresent},
+	rs := []resources.ResourceWithDesiredState{
+		{Resource: r.serviceAccount, DesiredState: desiredState},
+	}
+	if r.inControlPlaneNamespace() {
+		rs = append(rs,
+			resources.ResourceWithDesiredState{Resource: r.clusterRole, DesiredState: desiredState},
+			resources.ResourceWithDesiredState{Resource: r.clusterRoleBinding, DesiredState: desiredState},
+		)
+	} else {
+		// the cluster scoped names are only unique among the gateways of the control plane namespace
+		rs = append(rs,
+			resources.ResourceWithDesiredState{Resource: r.networkingRole, DesiredState: desiredState},
+			resources.ResourceWithDesiredState{Resource: r.networkingRoleBinding, DesiredState: desiredState},
+		)
+	}
+	rs = append(rs, []resources.ResourceWithDesiredState{
+		{Resource: r.deployment, DesiredState: desiredState},
+		{Resource: r.service, DesiredState: desiredState},
 		{Resource: r.horizontalPodAutoscaler, DesiredState: hpaDesiredState},
 		{Resource: r.podDisruptionBudget, DesiredState: pdbDesiredState},
 		{Resource: r.role, DesiredState: sdsDesiredState},
 		{Resource: r.roleBinding, DesiredState: sdsDesiredState},
-	} {
+	}...)
+
+	for _, res := range rs {
 		o := res.Resource()
 		err := k8sutils.Reconcile(log, r.Client, o, res.DesiredState)
 		if err != nil {
@@ -86,38 +105,3 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 
 	return nil
 }
-
-func (r *Reconciler) waitForIstiod() error {
-	if !utils.PointerToBool(r.Config.Spec.Istiod.Enabled) {
-		return nil
-	}
-
-	opt := &client.ListOptions{
-		Namespace: r.Config.Namespace,
-		LabelSelector: labels.SelectorFromSet(map[string]string{
-			"app": "istiod",
-		}),
-	}
-
-	var pods v1.PodList
-	err := r.Client.List(context.Background(), &pods, opt)
-	if err != nil {
-		return emperror.Wrap(err, "could not list pods")
-	}
-
-	for _, pod := range pods.Items {
-		if pod.Status.Phase == v1.PodRunning {
-			readyContainers := 0
-			for _, cs := range pod.Status.ContainerStatuses {
-				if cs.Ready {
-					readyContainers++
-				}
-			}
-			if readyContainers == len(pod.Status.ContainerStatuses) {
-				return nil
-			}
-		}
-	}
-
-	return errors.Errorf("Istiod is not running yet")
-}
@@ -13,16 +13,19 @@ func (r *Reconciler) serviceAccount() runtime.Object {
 	}
 }
 
+// networkingRules allow the gateway to read the Istio networking config
+var networkingRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{"networking.istio.io"},
+		Resources: []string{"virtualservices", "destinationrules", "gateways"},
+		Verbs:     []string{"get", "watch", "list", "update"},
+	},
+}
+
 func (r *Reconciler) clusterRole() runtime.Object {
 	return &rbacv1.ClusterRole{
 		ObjectMeta: templates.ObjectMetaClusterScope(r.clusterRoleName(), r.labelSelector(), r.gw),
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{"networking.istio.io"},
-				Resources: []string{"virtualservices", "destinationrules", "gateways"},
-				Verbs:     []string{"get", "watch", "list", "update"},
-			},
-		},
+		Rules:      networkingRules,
 	}
 }
 
@@ -38,7 +41,7 @@ func (r *Reconciler) clusterRoleBinding() runtime.Object {
 			{
 				Kind:      "ServiceAccount",
 				Name:      r.serviceAccountName(),
-				Namespace: r.Config.Namespace,
+				Namespace: r.gw.Namespace,
 			},
 		},
 	}
@@ -74,3 +77,30 @@ func (r *Reconciler) roleBinding() runtime.Object {
 		},
 	}
 }
+
+// networkingRole grants the networking rules of a gateway outside the namespace of the control plane
+// in its own namespace only
+func (r *Reconciler) networkingRole() runtime.Object {
+	return &rbacv1.Role{
+		ObjectMeta: templates.ObjectMeta(r.networkingRoleName(), r.labelSelector(), r.gw),
+		Rules:      networkingRules,
+	}
+}
+
+func (r *Reconciler) networkingRoleBinding() runtime.Object {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: templates.ObjectMeta(r.networkingRoleBindingName(), r.labelSelector(), r.gw),
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "Role",
+			APIGroup: "rbac.authorization.k8s.io",
+			Name:     r.networkingRoleName(),
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      r.serviceAccountName(),
+				Namespace: r.gw.Namespace,
+			},
+		},
+	}
+}
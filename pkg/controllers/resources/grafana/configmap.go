@@ -0,0 +1,59 @@
+package grafana
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/prometheus"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+)
+
+const dashboardsPath = "/var/lib/grafana/dashboards/istio"
+
+func (r *Reconciler) datasources() string {
+	return fmt.Sprintf(`apiVersion: 1
+datasources:
+- name: Prometheus
+  type: prometheus
+  orgId: 1
+  url: %s
+  access: proxy
+  isDefault: true
+  jsonData:
+    timeInterval: 5s
+  editable: true
+`, prometheus.URL(r.Config))
+}
+
+func (r *Reconciler) dashboardProviders() string {
+	return fmt.Sprintf(`apiVersion: 1
+providers:
+- name: istio
+  orgId: 1
+  folder: istio
+  type: file
+  disableDeletion: false
+  options:
+    path: %s
+`, dashboardsPath)
+}
+
+func (r *Reconciler) configMap() runtime.Object {
+	return &corev1.ConfigMap{
+		ObjectMeta: templates.ObjectMeta(configMapName, labels, r.Config),
+		Data: map[string]string{
+			"datasources.yaml":        r.datasources(),
+			"dashboardproviders.yaml": r.dashboardProviders(),
+		},
+	}
+}
+
+// dashboardsConfigMap holds the dashboards of the Istio version, a version change replaces them all
+func (r *Reconciler) dashboardsConfigMap() runtime.Object {
+	return &corev1.ConfigMap{
+		ObjectMeta: templates.ObjectMeta(dashboardsConfigMapName, labels, r.Config),
+		Data:       r.dashboards,
+	}
+}
@@ -0,0 +1,132 @@
+package grafana
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+func (r *Reconciler) container() corev1.Container {
+	return corev1.Container{
+		Name:            "grafana",
+		Image:           utils.PointerToString(r.Config.Spec.Grafana.Image),
+		ImagePullPolicy: r.Config.Spec.ImagePullPolicy,
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "http",
+				ContainerPort: 3000,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		Env: []corev1.EnvVar{
+			{Name: "GF_PATHS_DATA", Value: "/data/grafana"},
+			{Name: "GF_AUTH_BASIC_ENABLED", Value: "false"},
+			{Name: "GF_AUTH_ANONYMOUS_ENABLED", Value: "true"},
+			{Name: "GF_AUTH_ANONYMOUS_ORG_ROLE", Value: "Admin"},
+		},
+		ReadinessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/api/health",
+					Port:   intstr.FromInt(3000),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			PeriodSeconds:    10,
+			FailureThreshold: 3,
+			SuccessThreshold: 1,
+			TimeoutSeconds:   1,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "data",
+				MountPath: "/data/grafana",
+			},
+			{
+				Name:      "datasources",
+				MountPath: "/etc/grafana/provisioning/datasources",
+				ReadOnly:  true,
+			},
+			{
+				Name:      "dashboardproviders",
+				MountPath: "/etc/grafana/provisioning/dashboards",
+				ReadOnly:  true,
+			},
+			{
+				Name:      "dashboards",
+				MountPath: dashboardsPath,
+				ReadOnly:  true,
+			},
+		},
+		Resources: templates.GetResourcesRequirementsOrDefault(
+			r.Config.Spec.Grafana.Resources,
+			r.Config.Spec.DefaultResources,
+		),
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+}
+
+func configMapVolume(name, configMapName string, items ...corev1.KeyToPath) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMapName,
+				},
+				Items:       items,
+				DefaultMode: utils.IntPointer(420),
+			},
+		},
+	}
+}
+
+func (r *Reconciler) deployment() runtime.Object {
+	return &appsv1.Deployment{
+		ObjectMeta: templates.ObjectMeta(deploymentName, utils.MergeStringMaps(labels, labelSelector), r.Config),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: utils.IntPointer(1),
+			Strategy: templates.DefaultRollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labelSelector,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      utils.MergeStringMaps(labels, labelSelector),
+					Annotations: utils.MergeStringMaps(templates.DefaultDeployAnnotations(), r.Config.Spec.Grafana.PodAnnotations),
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						r.container(),
+					},
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsUser:  utils.Int64Pointer(472),
+						RunAsGroup: utils.Int64Pointer(472),
+						FSGroup:    utils.Int64Pointer(472),
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "data",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+						configMapVolume("datasources", configMapName, corev1.KeyToPath{Key: "datasources.yaml", Path: "datasources.yaml"}),
+						configMapVolume("dashboardproviders", configMapName, corev1.KeyToPath{Key: "dashboardproviders.yaml", Path: "dashboardproviders.yaml"}),
+						configMapVolume("dashboards", dashboardsConfigMapName),
+					},
+					Affinity:          r.Config.Spec.Grafana.Affinity,
+					NodeSelector:      r.Config.Spec.Grafana.NodeSelector,
+					Tolerations:       r.Config.Spec.Grafana.Tolerations,
+					PriorityClassName: r.Config.Spec.PriorityClassName,
+				},
+			},
+		},
+	}
+}
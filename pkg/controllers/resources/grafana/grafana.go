@@ -0,0 +1,101 @@
+package grafana
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/static"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+const (
+	componentName           = "grafana"
+	deploymentName          = "grafana"
+	configMapName           = "grafana"
+	dashboardsConfigMapName = "istio-grafana-dashboards"
+
+	// ServiceName is the name of the service of Grafana
+	ServiceName = "grafana"
+	// ServicePort is the port of the service of Grafana
+	ServicePort = 3000
+)
+
+var labels = map[string]string{
+	"app": "grafana",
+}
+
+var labelSelector = map[string]string{
+	"app": "grafana",
+}
+
+type Reconciler struct {
+	resources.Reconciler
+	dashboards map[string]string
+}
+
+func New(client client.Client, config *devopsv1beta1.Istio) *Reconciler {
+	return &Reconciler{
+		Reconciler: resources.Reconciler{
+			Client: client,
+			Config: config,
+		},
+	}
+}
+
+// URL returns the address of the Grafana installed for the Istio resource
+func URL(config *devopsv1beta1.Istio) string {
+	return fmt.Sprintf("http://%s.%s:%d", ServiceName, config.Namespace, ServicePort)
+}
+
+func (r *Reconciler) Reconcile(log logr.Logger) error {
+	var desiredState k8sutils.DesiredState
+	if utils.PointerToBool(r.Config.Spec.Grafana.Enabled) {
+		desiredState = k8sutils.DesiredStatePresent
+	} else {
+		desiredState = k8sutils.DesiredStateAbsent
+	}
+
+	return r.reconcile(log, desiredState)
+}
+
+// Cleanup removes the Grafana installation
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStateAbsent)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, desiredState k8sutils.DesiredState) error {
+	log = log.WithValues("component", componentName)
+
+	log.Info("Reconciling")
+
+	if desiredState == k8sutils.DesiredStatePresent {
+		var err error
+		r.dashboards, err = static.LoadGrafanaDashboards(r.Config.Spec.Version.MinorVersion())
+		if err != nil {
+			return emperror.Wrap(err, "could not load grafana dashboards")
+		}
+	}
+
+	for _, res := range []resources.Resource{
+		r.configMap,
+		r.dashboardsConfigMap,
+		r.service,
+		r.deployment,
+	} {
+		o := res()
+		err := k8sutils.Reconcile(log, r.Client, o, desiredState)
+		if err != nil {
+			return emperror.WrapWith(err, "failed to reconcile resource", "resource", o.GetObjectKind().GroupVersionKind())
+		}
+	}
+
+	log.Info("Reconciled")
+
+	return nil
+}
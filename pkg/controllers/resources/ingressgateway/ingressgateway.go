@@ -43,13 +43,22 @@ func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio
 }
 
 func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, false)
+}
+
+// Cleanup removes the mesh gateway and the Istio resources of the component
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, true)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, teardown bool) error {
 	log = log.WithValues("component", componentName)
 
 	log.Info("Reconciling")
 
 	var desiredState k8sutils.DesiredState
 
-	if utils.PointerToBool(r.Config.Spec.Gateways.Enabled) && utils.PointerToBool(r.Config.Spec.Gateways.IngressConfig.Enabled) {
+	if utils.PointerToBool(r.Config.Spec.Gateways.Enabled) && utils.PointerToBool(r.Config.Spec.Gateways.IngressConfig.Enabled) && !teardown {
 		desiredState = k8sutils.DesiredStatePresent
 	} else {
 		desiredState = k8sutils.DesiredStateAbsent
@@ -59,11 +68,18 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 		MeshGatewayConfiguration: r.Config.Spec.Gateways.IngressConfig.MeshGatewayConfiguration,
 		Ports:                    r.Config.Spec.Gateways.IngressConfig.Ports,
 		Type:                     devopsv1beta1.GatewayTypeIngress,
+		// the gateway belongs to the control plane creating it
+		IstioControlPlane: &devopsv1beta1.IstioControlPlaneReference{
+			Name:      r.Config.Name,
+			Namespace: r.Config.Namespace,
+		},
 	}
 	spec.Labels = r.labels()
 	object := &devopsv1beta1.MeshGateway{
-		ObjectMeta: templates.ObjectMeta(ResourceName, spec.Labels, r.Config),
-		Spec:       spec,
+		ObjectMeta: templates.ObjectMetaWithAnnotations(ResourceName, spec.Labels, map[string]string{
+			devopsv1beta1.IstioVersionAnnotation: string(r.Config.Status.Version),
+		}, r.Config),
+		Spec: spec,
 	}
 
 	err := k8sutils.Reconcile(log, r.Client, object, desiredState)
@@ -74,21 +90,22 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 	var k8sIngressDesiredState k8sutils.DesiredState
 	if utils.PointerToBool(r.Config.Spec.Gateways.Enabled) &&
 		utils.PointerToBool(r.Config.Spec.Gateways.IngressConfig.Enabled) &&
-		utils.PointerToBool(r.Config.Spec.Gateways.K8sIngress.Enabled) {
+		utils.PointerToBool(r.Config.Spec.Gateways.K8sIngress.Enabled) &&
+		!teardown {
 		k8sIngressDesiredState = k8sutils.DesiredStatePresent
 	} else {
 		k8sIngressDesiredState = k8sutils.DesiredStateAbsent
 	}
 
 	var meshExpansionDesiredState k8sutils.DesiredState
-	if utils.PointerToBool(r.Config.Spec.MeshExpansion) {
+	if utils.PointerToBool(r.Config.Spec.MeshExpansion) && !teardown {
 		meshExpansionDesiredState = k8sutils.DesiredStatePresent
 	} else {
 		meshExpansionDesiredState = k8sutils.DesiredStateAbsent
 	}
 
 	var multimeshDesiredState k8sutils.DesiredState
-	if utils.PointerToBool(r.Config.Spec.MultiMesh) {
+	if utils.PointerToBool(r.Config.Spec.MultiMesh) && !teardown {
 		multimeshDesiredState = k8sutils.DesiredStatePresent
 	} else {
 		multimeshDesiredState = k8sutils.DesiredStateAbsent
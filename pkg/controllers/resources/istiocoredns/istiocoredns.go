@@ -44,10 +44,6 @@ func New(client client.Client, config *devopsv1beta1.Istio) *Reconciler {
 }
 
 func (r *Reconciler) Reconcile(log logr.Logger) error {
-	log = log.WithValues("component", componentName)
-
-	log.Info("Reconciling")
-
 	var desiredState k8sutils.DesiredState
 	if utils.PointerToBool(r.Config.Spec.IstioCoreDNS.Enabled) {
 		desiredState = k8sutils.DesiredStatePresent
@@ -55,6 +51,19 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 		desiredState = k8sutils.DesiredStateAbsent
 	}
 
+	return r.reconcile(log, desiredState)
+}
+
+// Cleanup removes the resources of the component and reverts the .global stub domain of the cluster DNS
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStateAbsent)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, desiredState k8sutils.DesiredState) error {
+	log = log.WithValues("component", componentName)
+
+	log.Info("Reconciling")
+
 	for _, res := range []resources.Resource{
 		r.serviceAccount,
 		r.clusterRole,
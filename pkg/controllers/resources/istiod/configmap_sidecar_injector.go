@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
 	"github.com/symcn/mid-operator/pkg/controllers/resources/gateways"
 	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
 	"github.com/symcn/mid-operator/pkg/utils"
@@ -31,8 +32,11 @@ func (r *Reconciler) configMapInjector() runtime.Object {
 	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) {
 		labels = nil
 	}
+	if r.revision != "" {
+		labels = r.revisionLabels()
+	}
 	return &corev1.ConfigMap{
-		ObjectMeta: templates.ObjectMeta(configMapNameInjector, labels, r.Config),
+		ObjectMeta: templates.ObjectMeta(r.resourceName(configMapNameInjector), labels, r.Config),
 		Data: map[string]string{
 			"config": r.siConfig(),
 			"values": r.getValues(),
@@ -59,6 +63,12 @@ func (r *Reconciler) getValues() string {
 		proxyInitContainerName = "istio-validation"
 	}
 
+	// the proxies injected by a revision connect to the istiod of the revision instead of the one in the mesh config
+	discoveryAddress := ""
+	if r.revision != "" {
+		discoveryAddress = resources.GetDiscoveryAddress(r.Config, r.resourceName(ServiceNameIstiod))
+	}
+
 	values := map[string]interface{}{
 		"sidecarInjectorWebhook": map[string]interface{}{
 			"rewriteAppHTTPProbe": r.Config.Spec.SidecarInjector.RewriteAppHTTPProbe,
@@ -98,7 +108,8 @@ func (r *Reconciler) getValues() string {
 			"multicluster": map[string]interface{}{
 				"clusterName": r.Config.Spec.ClusterName,
 			},
-			"meshID": r.Config.Spec.MeshID,
+			"meshID":           r.Config.Spec.MeshID,
+			"discoveryAddress": discoveryAddress,
 			"proxy": map[string]interface{}{
 				"image":                        r.Config.Spec.Proxy.Image,
 				"statusPort":                   15020,
@@ -204,7 +215,7 @@ containers:
   - --parentShutdownDuration
   - "{{ formatDuration .ProxyConfig.ParentShutdownDuration }}"
   - --discoveryAddress
-  - "{{ annotation .ObjectMeta ` + "`" + `sidecar.istio.io/discoveryAddress` + "`" + ` .ProxyConfig.DiscoveryAddress }}"
+  - "{{ annotation .ObjectMeta ` + "`" + `sidecar.istio.io/discoveryAddress` + "`" + ` (valueOrDefault .Values.global.discoveryAddress .ProxyConfig.DiscoveryAddress) }}"
 ` + r.tracingProxyArgs() + `
 {{- if .Values.global.proxy.logLevel }}
   - --proxyLogLevel={{ .Values.global.proxy.logLevel }}
@@ -65,7 +65,7 @@ func (r *Reconciler) containerEnvs() []corev1.EnvVar {
 		},
 		{
 			Name:  "MESHNETWORKS_HASH",
-			Value: templates.GetMeshNetworksHash(r.Config),
+			Value: templates.GetMeshNetworksHash(r.Config, r.remoteIstios),
 		},
 		{
 			Name:  "PILOT_ENABLE_PROTOCOL_SNIFFING_FOR_OUTBOUND",
@@ -77,17 +77,24 @@ func (r *Reconciler) containerEnvs() []corev1.EnvVar {
 		},
 		{
 			Name:  "INJECTION_WEBHOOK_CONFIG_NAME",
-			Value: "istio-sidecar-injector",
+			Value: r.resourceName(ServiceNameInjector),
 		},
 	}
 
+	if r.revision != "" {
+		envs = append(envs, corev1.EnvVar{
+			Name:  "REVISION",
+			Value: r.revision,
+		})
+	}
+
 	envs = append(envs, templates.IstioProxyEnv(r.Config)...)
 
 	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) {
 		envs = append(envs, []corev1.EnvVar{
 			{
 				Name:  "ISTIOD_ADDR",
-				Value: resources.GetDiscoveryAddress(r.Config, "istiod"),
+				Value: resources.GetDiscoveryAddress(r.Config, r.resourceName(ServiceNameIstiod)),
 			},
 			{
 				Name:  "PILOT_EXTERNAL_GALLEY",
@@ -361,7 +368,7 @@ func (r *Reconciler) volumes() []corev1.Volume {
 				VolumeSource: corev1.VolumeSource{
 					ConfigMap: &corev1.ConfigMapVolumeSource{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: "istio-sidecar-injector",
+							Name: r.resourceName(configMapNameInjector),
 						},
 						Optional:    utils.BoolPointer(true),
 						DefaultMode: utils.IntPointer(420),
@@ -388,20 +395,29 @@ func (r *Reconciler) volumes() []corev1.Volume {
 }
 
 func (r *Reconciler) deployment() runtime.Object {
+	labels := utils.MergeStringMaps(istiodLabels, pilotLabelSelector)
+	podLabels := labels
+	selector := pilotLabelSelector
+	if r.revision != "" {
+		labels = r.revisionLabels()
+		podLabels = r.revisionSelector()
+		selector = r.revisionSelector()
+	}
+
 	deployment := &appsv1.Deployment{
-		ObjectMeta: templates.ObjectMeta(deploymentName, utils.MergeStringMaps(istiodLabels, pilotLabelSelector), r.Config),
+		ObjectMeta: templates.ObjectMeta(r.resourceName(DeploymentName), labels, r.Config),
 		Spec: appsv1.DeploymentSpec{
 			Replicas: utils.IntPointer(k8sutils.GetHPAReplicaCountOrDefault(r.Client, types.NamespacedName{
-				Name:      hpaName,
+				Name:      r.resourceName(hpaName),
 				Namespace: r.Config.Namespace,
 			}, utils.PointerToInt32(r.Config.Spec.Pilot.ReplicaCount))),
 			Strategy: templates.DefaultRollingUpdateStrategy(),
 			Selector: &metav1.LabelSelector{
-				MatchLabels: pilotLabelSelector,
+				MatchLabels: selector,
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels:      utils.MergeStringMaps(istiodLabels, pilotLabelSelector),
+					Labels:      podLabels,
 					Annotations: utils.MergeStringMaps(templates.DefaultDeployAnnotations(), r.Config.Spec.Pilot.PodAnnotations),
 				},
 				Spec: corev1.PodSpec{
@@ -14,7 +14,7 @@ func (r *Reconciler) horizontalPodAutoscaler() runtime.Object {
 			MaxReplicas: utils.PointerToInt32(r.Config.Spec.Pilot.MaxReplicas),
 			MinReplicas: r.Config.Spec.Pilot.MinReplicas,
 			ScaleTargetRef: autoscalev2beta1.CrossVersionObjectReference{
-				Name:       deploymentName,
+				Name:       DeploymentName,
 				Kind:       "Deployment",
 				APIVersion: "apps/v1",
 			},
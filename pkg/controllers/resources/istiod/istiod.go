@@ -3,6 +3,7 @@ package istiod
 import (
 	"github.com/go-logr/logr"
 	"github.com/goph/emperror"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -18,7 +19,7 @@ const (
 	clusterRoleNameIstiod        = "istiod-cluster-role"
 	clusterRoleBindingNameIstiod = "istiod-cluster-role-binding"
 	configMapNameEnvoy           = "pilot-envoy-config"
-	deploymentName               = "istiod"
+	DeploymentName               = "istiod"
 	ServiceNameIstiod            = "istiod"
 	ServiceNamePilot             = "istio-pilot"
 	hpaName                      = "istiod-autoscaler"
@@ -48,7 +49,10 @@ var sidecarInjectorLabels = map[string]string{
 
 type Reconciler struct {
 	resources.Reconciler
-	dynamic dynamic.Interface
+	dynamic      dynamic.Interface
+	remoteIstios []devopsv1beta1.RemoteIstio
+	// revision is set for the reconcilers of the additional istiod revisions
+	revision string
 }
 
 func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio) *Reconciler {
@@ -62,13 +66,28 @@ func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio
 }
 
 func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, false)
+}
+
+// Cleanup removes the resources of the component, including the ones of the istiod revisions
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, true)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, teardown bool) error {
 	log = log.WithValues("component", componentName)
 
 	log.Info("Reconciling")
 
+	remoteIstios, err := resources.GetRemoteIstios(r.Client, r.Config)
+	if err != nil {
+		return err
+	}
+	r.remoteIstios = remoteIstios
+
 	var istiodDesiredState k8sutils.DesiredState
 	var pdbDesiredState k8sutils.DesiredState
-	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) {
+	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) && !teardown {
 		istiodDesiredState = k8sutils.DesiredStatePresent
 		if utils.PointerToBool(r.Config.Spec.DefaultPodDisruptionBudget.Enabled) {
 			pdbDesiredState = k8sutils.DesiredStatePresent
@@ -101,9 +120,14 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 		}
 	}
 
+	err = r.reconcileRevisions(log, teardown)
+	if err != nil {
+		return err
+	}
+
 	var meshExpansionDesiredState k8sutils.DesiredState
 	var meshExpansionDestinationRuleDesiredState k8sutils.DesiredState
-	if utils.PointerToBool(r.Config.Spec.MeshExpansion) {
+	if utils.PointerToBool(r.Config.Spec.MeshExpansion) && !teardown {
 		meshExpansionDesiredState = k8sutils.DesiredStatePresent
 		if r.Config.Spec.ControlPlaneSecurityEnabled {
 			meshExpansionDestinationRuleDesiredState = k8sutils.DesiredStatePresent
@@ -128,3 +152,27 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 
 	return nil
 }
+
+// IsRolledOut returns whether the istiod deployment of the control plane is fully rolled out
+func IsRolledOut(client client.Client, config *devopsv1beta1.Istio) (bool, error) {
+	if !utils.PointerToBool(config.Spec.Istiod.Enabled) {
+		return true, nil
+	}
+
+	return k8sutils.IsDeploymentRolledOut(client, types.NamespacedName{
+		Name:      DeploymentName,
+		Namespace: config.Namespace,
+	})
+}
+
+// IsReady returns whether a replica of the istiod deployment of the control plane is available to serve the proxies
+func IsReady(client client.Client, config *devopsv1beta1.Istio) (bool, error) {
+	if !utils.PointerToBool(config.Spec.Istiod.Enabled) {
+		return true, nil
+	}
+
+	return k8sutils.IsDeploymentAvailable(client, types.NamespacedName{
+		Name:      DeploymentName,
+		Namespace: config.Namespace,
+	})
+}
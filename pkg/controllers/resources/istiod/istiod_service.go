@@ -10,8 +10,15 @@ import (
 )
 
 func (r *Reconciler) istiodService() runtime.Object {
+	labels := istiodLabels
+	selector := utils.MergeStringMaps(istiodLabels, pilotLabelSelector)
+	if r.revision != "" {
+		labels = r.revisionLabels()
+		selector = r.revisionSelector()
+	}
+
 	return &corev1.Service{
-		ObjectMeta: templates.ObjectMeta(ServiceNameIstiod, istiodLabels, r.Config),
+		ObjectMeta: templates.ObjectMeta(r.resourceName(ServiceNameIstiod), labels, r.Config),
 		Spec: corev1.ServiceSpec{
 			Ports: []corev1.ServicePort{
 				{
@@ -27,7 +34,7 @@ func (r *Reconciler) istiodService() runtime.Object {
 					Protocol:   corev1.ProtocolTCP,
 				},
 			},
-			Selector: utils.MergeStringMaps(istiodLabels, pilotLabelSelector),
+			Selector: selector,
 		},
 	}
 }
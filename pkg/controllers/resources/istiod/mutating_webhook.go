@@ -73,6 +73,26 @@ func (r *Reconciler) mutatingWebhook() runtime.Object {
 		}
 	}
 
+	if r.revision != "" {
+		webhook.ObjectMeta = templates.ObjectMetaClusterScope(r.resourceName(ServiceNameInjector), r.revisionLabels(), r.Config)
+		webhook.Webhooks[0].ClientConfig.Service.Name = r.resourceName(ServiceNameIstiod)
+		webhook.Webhooks[0].NamespaceSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      "istio-injection",
+					Operator: metav1.LabelSelectorOpDoesNotExist,
+				},
+				{
+					Key:      RevisionLabel,
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   []string{r.revision},
+				},
+			},
+		}
+
+		return webhook
+	}
+
 	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) {
 		webhook.Webhooks[0].NamespaceSelector.MatchExpressions = append(webhook.Webhooks[0].NamespaceSelector.MatchExpressions, []metav1.LabelSelectorRequirement{
 			{
@@ -80,7 +100,7 @@ func (r *Reconciler) mutatingWebhook() runtime.Object {
 				Operator: metav1.LabelSelectorOpDoesNotExist,
 			},
 			{
-				Key:      "istio.io/rev",
+				Key:      RevisionLabel,
 				Operator: metav1.LabelSelectorOpDoesNotExist,
 			},
 		}...)
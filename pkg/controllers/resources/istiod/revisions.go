@@ -0,0 +1,136 @@
+package istiod
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+const (
+	// RevisionLabel is the namespace label selecting the istiod revision injecting the pods of the namespace
+	RevisionLabel = "istio.io/rev"
+)
+
+// newRevisionReconciler returns a reconciler of the per revision resources, the revision settings override the ones
+// of the default control plane
+func newRevisionReconciler(r *Reconciler, revision devopsv1beta1.IstiodRevision) *Reconciler {
+	config := r.Config.DeepCopy()
+	config.Spec.Version = revision.Version
+	config.Spec.Pilot.Image = revision.Image
+	config.Spec.Pilot.ReplicaCount = revision.ReplicaCount
+	config.Spec.Proxy.Image = revision.ProxyImage
+	config.Spec.ProxyInit.Image = revision.ProxyImage
+
+	return &Reconciler{
+		Reconciler: resources.Reconciler{
+			Client: r.Client,
+			Config: config,
+		},
+		dynamic:      r.dynamic,
+		remoteIstios: r.remoteIstios,
+		revision:     revision.Name,
+	}
+}
+
+// resourceName returns the name of a resource, suffixed with the revision for the revision resources
+func (r *Reconciler) resourceName(name string) string {
+	if r.revision == "" {
+		return name
+	}
+
+	return name + "-" + r.revision
+}
+
+func (r *Reconciler) revisionSelector() map[string]string {
+	return map[string]string{
+		"app":         "istiod-" + r.revision,
+		RevisionLabel: r.revision,
+	}
+}
+
+func (r *Reconciler) revisionLabels() map[string]string {
+	return utils.MergeStringMaps(r.revisionSelector(), map[string]string{
+		utils.CreatedByLabel: utils.CreatedBy,
+	})
+}
+
+func (r *Reconciler) reconcileRevisions(log logr.Logger, teardown bool) error {
+	revisions := make(map[string]bool)
+	if utils.PointerToBool(r.Config.Spec.Istiod.Enabled) && !teardown {
+		for _, revision := range r.Config.Spec.Istiod.Revisions {
+			rr := newRevisionReconciler(r, revision)
+			revisionLog := log.WithValues("revision", revision.Name)
+			for _, res := range []resources.Resource{
+				rr.configMapInjector,
+				rr.deployment,
+				rr.istiodService,
+				rr.mutatingWebhook,
+			} {
+				o := res()
+				err := k8sutils.Reconcile(revisionLog, r.Client, o, k8sutils.DesiredStatePresent)
+				if err != nil {
+					return emperror.WrapWith(err, "failed to reconcile resource", "resource", o.GetObjectKind().GroupVersionKind(), "revision", revision.Name)
+				}
+			}
+			revisions[revision.Name] = true
+		}
+	}
+
+	return r.removeStaleRevisions(log, revisions)
+}
+
+// removeStaleRevisions deletes the resources of the revisions no longer present in the spec
+func (r *Reconciler) removeStaleRevisions(log logr.Logger, revisions map[string]bool) error {
+	for _, list := range []runtime.Object{
+		&appsv1.DeploymentList{},
+		&corev1.ServiceList{},
+		&corev1.ConfigMapList{},
+		&admissionv1beta1.MutatingWebhookConfigurationList{},
+	} {
+		opts := []client.ListOption{
+			client.MatchingLabels{utils.CreatedByLabel: utils.CreatedBy},
+			client.HasLabels{RevisionLabel},
+		}
+		if _, ok := list.(*admissionv1beta1.MutatingWebhookConfigurationList); !ok {
+			opts = append(opts, client.InNamespace(r.Config.Namespace))
+		}
+		err := r.Client.List(context.TODO(), list, opts...)
+		if err != nil {
+			return emperror.Wrap(err, "could not list istiod revision resources")
+		}
+
+		objects, err := meta.ExtractList(list)
+		if err != nil {
+			return emperror.Wrap(err, "could not extract istiod revision resources")
+		}
+		for _, o := range objects {
+			m, err := meta.Accessor(o)
+			if err != nil {
+				return emperror.Wrap(err, "could not access istiod revision resource")
+			}
+			if revisions[m.GetLabels()[RevisionLabel]] || !metav1.IsControlledBy(m, r.Config) {
+				continue
+			}
+
+			err = k8sutils.Reconcile(log.WithValues("revision", m.GetLabels()[RevisionLabel]), r.Client, o, k8sutils.DesiredStateAbsent)
+			if err != nil {
+				return emperror.WrapWith(err, "failed to remove istiod revision resource", "name", m.GetName())
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package kiali
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/grafana"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/istiod"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/prometheus"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+// tracingURL returns the address of the tracing query service, the Jaeger collecting the zipkin spans
+// serves its query API on the same host
+func (r *Reconciler) tracingURL() string {
+	if r.Config.Spec.Kiali.TracingURL != "" {
+		return r.Config.Spec.Kiali.TracingURL
+	}
+	if !utils.PointerToBool(r.Config.Spec.Tracing.Enabled) || r.Config.Spec.Tracing.Tracer != devopsv1beta1.TracerTypeZipkin {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(r.Config.Spec.Tracing.Zipkin.Address)
+	if err != nil {
+		host = r.Config.Spec.Tracing.Zipkin.Address
+	}
+
+	return fmt.Sprintf("http://%s:16686", host)
+}
+
+func (r *Reconciler) config() string {
+	tracingURL := r.tracingURL()
+	grafanaEnabled := utils.PointerToBool(r.Config.Spec.Grafana.Enabled)
+
+	config := map[string]interface{}{
+		"istio_namespace": r.Config.Namespace,
+		"auth": map[string]interface{}{
+			"strategy": r.Config.Spec.Kiali.AuthStrategy,
+		},
+		"deployment": map[string]interface{}{
+			"accessible_namespaces": r.Config.Spec.Kiali.AccessibleNamespaces,
+		},
+		"server": map[string]interface{}{
+			"port":     servicePort,
+			"web_root": webRoot,
+		},
+		"external_services": map[string]interface{}{
+			"istio": map[string]interface{}{
+				"url_service_version": fmt.Sprintf("http://%s.%s:8080/version", istiod.ServiceNamePilot, r.Config.Namespace),
+			},
+			"prometheus": map[string]interface{}{
+				"url": prometheus.URL(r.Config),
+			},
+			"tracing": map[string]interface{}{
+				"enabled":        tracingURL != "",
+				"in_cluster_url": tracingURL,
+			},
+			"grafana": map[string]interface{}{
+				"enabled":        grafanaEnabled,
+				"in_cluster_url": grafana.URL(r.Config),
+			},
+		},
+	}
+
+	marshaledConfig, _ := yaml.Marshal(config)
+	// this is a static config, so we don't have to deal with errors
+	return string(marshaledConfig)
+}
+
+func (r *Reconciler) configMap() runtime.Object {
+	return &corev1.ConfigMap{
+		ObjectMeta: templates.ObjectMeta(configMapName, labels, r.Config),
+		Data: map[string]string{
+			"config.yaml": r.config(),
+		},
+	}
+}
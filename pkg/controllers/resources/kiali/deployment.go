@@ -0,0 +1,119 @@
+package kiali
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+func (r *Reconciler) probe() *corev1.Probe {
+	return &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   webRoot + "/healthz",
+				Port:   intstr.FromInt(servicePort),
+				Scheme: corev1.URISchemeHTTP,
+			},
+		},
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       30,
+		FailureThreshold:    3,
+		SuccessThreshold:    1,
+		TimeoutSeconds:      1,
+	}
+}
+
+func (r *Reconciler) container() corev1.Container {
+	return corev1.Container{
+		Name:            "kiali",
+		Image:           utils.PointerToString(r.Config.Spec.Kiali.Image),
+		ImagePullPolicy: r.Config.Spec.ImagePullPolicy,
+		Command: []string{
+			"/opt/kiali/kiali",
+			"-config",
+			"/kiali-configuration/config.yaml",
+			"-v",
+			"3",
+		},
+		Env: []corev1.EnvVar{
+			{
+				Name: "ACTIVE_NAMESPACE",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{
+						APIVersion: "v1",
+						FieldPath:  "metadata.namespace",
+					},
+				},
+			},
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "api-port",
+				ContainerPort: servicePort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		ReadinessProbe: r.probe(),
+		LivenessProbe:  r.probe(),
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "kiali-configuration",
+				MountPath: "/kiali-configuration",
+				ReadOnly:  true,
+			},
+		},
+		Resources: templates.GetResourcesRequirementsOrDefault(
+			r.Config.Spec.Kiali.Resources,
+			r.Config.Spec.DefaultResources,
+		),
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+}
+
+func (r *Reconciler) deployment() runtime.Object {
+	return &appsv1.Deployment{
+		ObjectMeta: templates.ObjectMeta(deploymentName, utils.MergeStringMaps(labels, labelSelector), r.Config),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: utils.IntPointer(1),
+			Strategy: templates.DefaultRollingUpdateStrategy(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labelSelector,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      utils.MergeStringMaps(labels, labelSelector),
+					Annotations: utils.MergeStringMaps(templates.DefaultDeployAnnotations(), r.Config.Spec.Kiali.PodAnnotations),
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: serviceAccountName,
+					Containers: []corev1.Container{
+						r.container(),
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "kiali-configuration",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: configMapName,
+									},
+									DefaultMode: utils.IntPointer(420),
+								},
+							},
+						},
+					},
+					Affinity:          r.Config.Spec.Kiali.Affinity,
+					NodeSelector:      r.Config.Spec.Kiali.NodeSelector,
+					Tolerations:       r.Config.Spec.Kiali.Tolerations,
+					PriorityClassName: r.Config.Spec.PriorityClassName,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package kiali
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+const (
+	componentName          = "kiali"
+	deploymentName         = "kiali"
+	configMapName          = "kiali"
+	serviceAccountName     = "kiali-service-account"
+	clusterRoleName        = "kiali"
+	clusterRoleBindingName = "kiali"
+	serviceName            = "kiali"
+	servicePort            = 20001
+	webRoot                = "/kiali"
+)
+
+var labels = map[string]string{
+	"app": "kiali",
+}
+
+var labelSelector = map[string]string{
+	"app": "kiali",
+}
+
+type Reconciler struct {
+	resources.Reconciler
+}
+
+func New(client client.Client, config *devopsv1beta1.Istio) *Reconciler {
+	return &Reconciler{
+		Reconciler: resources.Reconciler{
+			Client: client,
+			Config: config,
+		},
+	}
+}
+
+func (r *Reconciler) Reconcile(log logr.Logger) error {
+	var desiredState k8sutils.DesiredState
+	if utils.PointerToBool(r.Config.Spec.Kiali.Enabled) {
+		desiredState = k8sutils.DesiredStatePresent
+	} else {
+		desiredState = k8sutils.DesiredStateAbsent
+	}
+
+	return r.reconcile(log, desiredState)
+}
+
+// Cleanup removes the Kiali installation along with its cluster scoped RBAC resources
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStateAbsent)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, desiredState k8sutils.DesiredState) error {
+	log = log.WithValues("component", componentName)
+
+	log.Info("Reconciling")
+
+	for _, res := range []resources.Resource{
+		r.serviceAccount,
+		r.clusterRole,
+		r.clusterRoleBinding,
+		r.configMap,
+		r.service,
+		r.deployment,
+	} {
+		o := res()
+		err := k8sutils.Reconcile(log, r.Client, o, desiredState)
+		if err != nil {
+			return emperror.WrapWith(err, "failed to reconcile resource", "resource", o.GetObjectKind().GroupVersionKind())
+		}
+	}
+
+	log.Info("Reconciled")
+
+	return nil
+}
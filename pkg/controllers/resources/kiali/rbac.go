@@ -0,0 +1,76 @@
+package kiali
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+)
+
+func (r *Reconciler) serviceAccount() runtime.Object {
+	return &apiv1.ServiceAccount{
+		ObjectMeta: templates.ObjectMeta(serviceAccountName, labels, r.Config),
+	}
+}
+
+func (r *Reconciler) clusterRole() runtime.Object {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: templates.ObjectMetaClusterScope(clusterRoleName+"-"+r.Config.Namespace, labels, r.Config),
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps", "endpoints", "namespaces", "nodes", "pods", "pods/log", "pods/proxy", "replicationcontrollers", "services"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods/portforward"},
+				Verbs:     []string{"create", "post"},
+			},
+			{
+				APIGroups: []string{"extensions", "apps"},
+				Resources: []string{"daemonsets", "deployments", "replicasets", "statefulsets"},
+				Verbs:     []string{"get", "list", "watch", "patch"},
+			},
+			{
+				APIGroups: []string{"autoscaling"},
+				Resources: []string{"horizontalpodautoscalers"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"batch"},
+				Resources: []string{"cronjobs", "jobs"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"config.istio.io", "networking.istio.io", "authentication.istio.io", "rbac.istio.io", "security.istio.io"},
+				Resources: []string{"*"},
+				Verbs:     []string{"create", "delete", "get", "list", "patch", "watch"},
+			},
+			{
+				APIGroups: []string{"monitoring.kiali.io"},
+				Resources: []string{"monitoringdashboards"},
+				Verbs:     []string{"get", "list"},
+			},
+		},
+	}
+}
+
+func (r *Reconciler) clusterRoleBinding() runtime.Object {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: templates.ObjectMetaClusterScope(clusterRoleBindingName+"-"+r.Config.Namespace, labels, r.Config),
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "ClusterRole",
+			APIGroup: "rbac.authorization.k8s.io",
+			Name:     clusterRoleName + "-" + r.Config.Namespace,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      serviceAccountName,
+				Namespace: r.Config.Namespace,
+			},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package kiali
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+)
+
+func (r *Reconciler) service() runtime.Object {
+	return &corev1.Service{
+		ObjectMeta: templates.ObjectMeta(serviceName, labels, r.Config),
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http-kiali",
+					Port:       servicePort,
+					Protocol:   corev1.ProtocolTCP,
+					TargetPort: intstr.FromInt(servicePort),
+				},
+			},
+			Selector: labelSelector,
+		},
+	}
+}
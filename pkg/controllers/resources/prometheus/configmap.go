@@ -0,0 +1,84 @@
+package prometheus
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+)
+
+// scrapeConfig scrapes the control plane monitoring port of istiod, the envoy stats of the gateways and sidecars,
+// and the pods annotated for scraping, which includes the application metrics merged into the sidecar
+func (r *Reconciler) scrapeConfig() string {
+	return fmt.Sprintf(`global:
+  scrape_interval: 15s
+scrape_configs:
+- job_name: istiod
+  kubernetes_sd_configs:
+  - role: endpoints
+    namespaces:
+      names:
+      - %[1]s
+  relabel_configs:
+  - source_labels: [__meta_kubernetes_service_name, __meta_kubernetes_endpoint_port_name]
+    action: keep
+    regex: istio-pilot;http-monitoring
+
+- job_name: envoy-stats
+  metrics_path: /stats/prometheus
+  kubernetes_sd_configs:
+  - role: pod
+  relabel_configs:
+  - source_labels: [__meta_kubernetes_pod_container_port_name]
+    action: keep
+    regex: '.*-envoy-prom'
+  # the envoy stats of the pods annotated for scraping are merged into their metrics
+  - source_labels: [__meta_kubernetes_pod_annotation_prometheus_io_scrape]
+    action: drop
+    regex: true
+  - action: labelmap
+    regex: __meta_kubernetes_pod_label_(.+)
+  - source_labels: [__meta_kubernetes_namespace]
+    action: replace
+    target_label: namespace
+  - source_labels: [__meta_kubernetes_pod_name]
+    action: replace
+    target_label: pod_name
+
+- job_name: kubernetes-pods
+  kubernetes_sd_configs:
+  - role: pod
+  relabel_configs:
+  - source_labels: [__meta_kubernetes_pod_annotation_prometheus_io_scrape]
+    action: keep
+    regex: true
+  - source_labels: [__meta_kubernetes_pod_annotation_prometheus_io_path]
+    action: replace
+    target_label: __metrics_path__
+    regex: (.+)
+  - source_labels: [__address__, __meta_kubernetes_pod_annotation_prometheus_io_port]
+    action: replace
+    regex: ([^:]+)(?::\d+)?;(\d+)
+    replacement: $1:$2
+    target_label: __address__
+  - action: labelmap
+    regex: __meta_kubernetes_pod_label_(.+)
+  - source_labels: [__meta_kubernetes_namespace]
+    action: replace
+    target_label: namespace
+  - source_labels: [__meta_kubernetes_pod_name]
+    action: replace
+    target_label: pod_name
+`, r.Config.Namespace)
+}
+
+func (r *Reconciler) configMap() runtime.Object {
+	return &corev1.ConfigMap{
+		ObjectMeta: templates.ObjectMeta(configMapName, labels, r.Config),
+		Data: map[string]string{
+			"prometheus.yml": r.scrapeConfig(),
+		},
+	}
+}
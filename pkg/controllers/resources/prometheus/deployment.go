@@ -0,0 +1,151 @@
+package prometheus
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+func (r *Reconciler) container() corev1.Container {
+	return corev1.Container{
+		Name:            "prometheus",
+		Image:           utils.PointerToString(r.Config.Spec.Prometheus.Image),
+		ImagePullPolicy: r.Config.Spec.ImagePullPolicy,
+		Args: []string{
+			"--storage.tsdb.retention.time=" + r.Config.Spec.Prometheus.Retention,
+			"--storage.tsdb.path=/prometheus",
+			"--config.file=/etc/prometheus/prometheus.yml",
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "http",
+				ContainerPort: 9090,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		ReadinessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/-/ready",
+					Port:   intstr.FromInt(9090),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       5,
+			FailureThreshold:    3,
+			SuccessThreshold:    1,
+			TimeoutSeconds:      3,
+		},
+		LivenessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/-/healthy",
+					Port:   intstr.FromInt(9090),
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			InitialDelaySeconds: 30,
+			PeriodSeconds:       15,
+			FailureThreshold:    3,
+			SuccessThreshold:    1,
+			TimeoutSeconds:      3,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      "config-volume",
+				MountPath: "/etc/prometheus",
+				ReadOnly:  true,
+			},
+			{
+				Name:      "storage-volume",
+				MountPath: "/prometheus",
+			},
+		},
+		Resources: templates.GetResourcesRequirementsOrDefault(
+			r.Config.Spec.Prometheus.Resources,
+			r.Config.Spec.DefaultResources,
+		),
+		TerminationMessagePath:   corev1.TerminationMessagePathDefault,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+}
+
+func (r *Reconciler) storageVolumeSource() corev1.VolumeSource {
+	if r.Config.Spec.Prometheus.Storage == nil {
+		return corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}
+	}
+
+	return corev1.VolumeSource{
+		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: pvcName,
+		},
+	}
+}
+
+func (r *Reconciler) deployment() runtime.Object {
+	strategy := templates.DefaultRollingUpdateStrategy()
+	if r.Config.Spec.Prometheus.Storage != nil {
+		// the volume can only be attached to a single node, the old pod has to release it first
+		strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RecreateDeploymentStrategyType,
+		}
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: templates.ObjectMeta(deploymentName, utils.MergeStringMaps(labels, labelSelector), r.Config),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: utils.IntPointer(1),
+			Strategy: strategy,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labelSelector,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      utils.MergeStringMaps(labels, labelSelector),
+					Annotations: utils.MergeStringMaps(templates.DefaultDeployAnnotations(), r.Config.Spec.Prometheus.PodAnnotations),
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: serviceAccountName,
+					Containers: []corev1.Container{
+						r.container(),
+					},
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsUser:    utils.Int64Pointer(65534),
+						RunAsGroup:   utils.Int64Pointer(65534),
+						RunAsNonRoot: utils.BoolPointer(true),
+						FSGroup:      utils.Int64Pointer(65534),
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "config-volume",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: configMapName,
+									},
+									DefaultMode: utils.IntPointer(420),
+								},
+							},
+						},
+						{
+							Name:         "storage-volume",
+							VolumeSource: r.storageVolumeSource(),
+						},
+					},
+					Affinity:          r.Config.Spec.Prometheus.Affinity,
+					NodeSelector:      r.Config.Spec.Prometheus.NodeSelector,
+					Tolerations:       r.Config.Spec.Prometheus.Tolerations,
+					PriorityClassName: r.Config.Spec.PriorityClassName,
+				},
+			},
+		},
+	}
+}
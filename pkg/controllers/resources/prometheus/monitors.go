@@ -0,0 +1,97 @@
+package prometheus
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+)
+
+var serviceMonitorGvr = schema.GroupVersionResource{
+	Group:    "monitoring.coreos.com",
+	Version:  "v1",
+	Resource: "servicemonitors",
+}
+
+var podMonitorGvr = schema.GroupVersionResource{
+	Group:    "monitoring.coreos.com",
+	Version:  "v1",
+	Resource: "podmonitors",
+}
+
+// serviceMonitor scrapes the control plane monitoring port of istiod
+func (r *Reconciler) serviceMonitor() *k8sutils.DynamicObject {
+	return &k8sutils.DynamicObject{
+		Gvr:       serviceMonitorGvr,
+		Kind:      "ServiceMonitor",
+		Name:      serviceMonitorName,
+		Namespace: r.Config.Namespace,
+		Labels:    labels,
+		Spec: map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					"istio": "pilot",
+				},
+			},
+			"namespaceSelector": map[string]interface{}{
+				"matchNames": []interface{}{
+					r.Config.Namespace,
+				},
+			},
+			"endpoints": []interface{}{
+				map[string]interface{}{
+					"port":     "http-monitoring",
+					"interval": "15s",
+				},
+			},
+		},
+		Owner: r.Config,
+	}
+}
+
+// podMonitor scrapes the envoy stats of the gateways and sidecars of every namespace
+func (r *Reconciler) podMonitor() *k8sutils.DynamicObject {
+	return &k8sutils.DynamicObject{
+		Gvr:       podMonitorGvr,
+		Kind:      "PodMonitor",
+		Name:      podMonitorName,
+		Namespace: r.Config.Namespace,
+		Labels:    labels,
+		Spec: map[string]interface{}{
+			"selector": map[string]interface{}{
+				"matchExpressions": []interface{}{
+					map[string]interface{}{
+						"key":      "istio-prometheus-ignore",
+						"operator": "DoesNotExist",
+					},
+				},
+			},
+			"namespaceSelector": map[string]interface{}{
+				"any": true,
+			},
+			"jobLabel": "envoy-stats",
+			"podMetricsEndpoints": []interface{}{
+				map[string]interface{}{
+					"path":     "/stats/prometheus",
+					"interval": "15s",
+					"relabelings": []interface{}{
+						map[string]interface{}{
+							"sourceLabels": []interface{}{"__meta_kubernetes_pod_container_port_name"},
+							"action":       "keep",
+							"regex":        ".*-envoy-prom",
+						},
+						map[string]interface{}{
+							"sourceLabels": []interface{}{"__meta_kubernetes_pod_annotation_prometheus_io_scrape"},
+							"action":       "drop",
+							"regex":        "true",
+						},
+						map[string]interface{}{
+							"action": "labelmap",
+							"regex":  "__meta_kubernetes_pod_label_(.+)",
+						},
+					},
+				},
+			},
+		},
+		Owner: r.Config,
+	}
+}
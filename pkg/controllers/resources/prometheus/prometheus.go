@@ -0,0 +1,170 @@
+package prometheus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	"github.com/pkg/errors"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+const (
+	componentName          = "prometheus"
+	deploymentName         = "prometheus"
+	configMapName          = "prometheus"
+	serviceAccountName     = "prometheus"
+	clusterRoleName        = "prometheus"
+	clusterRoleBindingName = "prometheus"
+	pvcName                = "prometheus"
+	serviceMonitorName     = "istiod"
+	podMonitorName         = "envoy-stats"
+
+	// ServiceName is the name of the service of the Prometheus installed by the operator
+	ServiceName = "prometheus"
+	// ServicePort is the port of the service of the Prometheus installed by the operator
+	ServicePort = 9090
+)
+
+var labels = map[string]string{
+	"app": "prometheus",
+}
+
+var labelSelector = map[string]string{
+	"app": "prometheus",
+}
+
+// prometheusOperatorCRDs are the CRDs of the prometheus-operator kinds the component creates
+var prometheusOperatorCRDs = []string{
+	"servicemonitors.monitoring.coreos.com",
+	"podmonitors.monitoring.coreos.com",
+}
+
+type Reconciler struct {
+	resources.Reconciler
+	dynamic dynamic.Interface
+}
+
+func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio) *Reconciler {
+	return &Reconciler{
+		Reconciler: resources.Reconciler{
+			Client: client,
+			Config: config,
+		},
+		dynamic: dc,
+	}
+}
+
+// URL returns the address of the Prometheus queried by the addons, the one installed for the Istio resource unless set
+func URL(config *devopsv1beta1.Istio) string {
+	if config.Spec.Prometheus.URL != "" {
+		return config.Spec.Prometheus.URL
+	}
+
+	return fmt.Sprintf("http://%s.%s:%d", ServiceName, config.Namespace, ServicePort)
+}
+
+func (r *Reconciler) Reconcile(log logr.Logger) error {
+	if !utils.PointerToBool(r.Config.Spec.Prometheus.Enabled) {
+		return r.reconcile(log, k8sutils.DesiredStateAbsent, k8sutils.DesiredStateAbsent)
+	}
+
+	operator, err := r.isPrometheusOperatorMode()
+	if err != nil {
+		return err
+	}
+	if operator {
+		err := r.reconcile(log, k8sutils.DesiredStateAbsent, k8sutils.DesiredStatePresent)
+		if err != nil {
+			return err
+		}
+		// the addons would otherwise query the Prometheus that is not installed
+		if r.Config.Spec.Prometheus.URL == "" {
+			return errors.New("the prometheus url has to be set when the metrics are collected by a prometheus-operator")
+		}
+
+		return nil
+	}
+
+	return r.reconcile(log, k8sutils.DesiredStatePresent, k8sutils.DesiredStateAbsent)
+}
+
+// Cleanup removes the Prometheus installation along with the monitors and the cluster scoped RBAC resources
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStateAbsent, k8sutils.DesiredStateAbsent)
+}
+
+// reconcile installs Prometheus or creates the monitors picked up by an existing prometheus-operator, never both
+func (r *Reconciler) reconcile(log logr.Logger, prometheusState, monitorsState k8sutils.DesiredState) error {
+	log = log.WithValues("component", componentName)
+
+	log.Info("Reconciling")
+
+	pvcState := prometheusState
+	if r.Config.Spec.Prometheus.Storage == nil {
+		pvcState = k8sutils.DesiredStateAbsent
+	}
+	err := r.reconcilePersistentVolumeClaim(log, pvcState)
+	if err != nil {
+		return emperror.Wrap(err, "failed to reconcile persistent volume claim")
+	}
+
+	for _, res := range []resources.Resource{
+		r.serviceAccount,
+		r.clusterRole,
+		r.clusterRoleBinding,
+		r.configMap,
+		r.service,
+		r.deployment,
+	} {
+		o := res()
+		err := k8sutils.Reconcile(log, r.Client, o, prometheusState)
+		if err != nil {
+			return emperror.WrapWith(err, "failed to reconcile resource", "resource", o.GetObjectKind().GroupVersionKind())
+		}
+	}
+
+	for _, o := range []*k8sutils.DynamicObject{
+		r.serviceMonitor(),
+		r.podMonitor(),
+	} {
+		err := o.Reconcile(log, r.dynamic, monitorsState)
+		if err != nil {
+			return emperror.WrapWith(err, "failed to reconcile dynamic resource", "resource", o.Gvr)
+		}
+	}
+
+	log.Info("Reconciled")
+
+	return nil
+}
+
+// isPrometheusOperatorMode tells whether the monitors are created instead of installing Prometheus,
+// unless set explicitly this is the case when the prometheus-operator CRDs are installed
+func (r *Reconciler) isPrometheusOperatorMode() (bool, error) {
+	if r.Config.Spec.Prometheus.PrometheusOperator != nil {
+		return *r.Config.Spec.Prometheus.PrometheusOperator, nil
+	}
+
+	for _, name := range prometheusOperatorCRDs {
+		var crd apiextensionsv1beta1.CustomResourceDefinition
+		err := r.Client.Get(context.TODO(), client.ObjectKey{Name: name}, &crd)
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, emperror.WrapWith(err, "could not get crd", "name", name)
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,74 @@
+package prometheus
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/metrics"
+)
+
+func (r *Reconciler) persistentVolumeClaim() (*corev1.PersistentVolumeClaim, error) {
+	storage := r.Config.Spec.Prometheus.Storage
+	size, err := resource.ParseQuantity(storage.Size)
+	if err != nil {
+		return nil, emperror.WrapWith(err, "invalid prometheus storage size", "size", storage.Size)
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: templates.ObjectMeta(pvcName, labels, r.Config),
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+			StorageClassName: storage.StorageClassName,
+		},
+	}, nil
+}
+
+// reconcilePersistentVolumeClaim only ever creates or deletes the claim, the spec of a bound claim is immutable
+// and re-creating it on a change would lose the samples
+func (r *Reconciler) reconcilePersistentVolumeClaim(log logr.Logger, desiredState k8sutils.DesiredState) error {
+	var current corev1.PersistentVolumeClaim
+	err := r.Client.Get(context.TODO(), client.ObjectKey{Name: pvcName, Namespace: r.Config.Namespace}, &current)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return emperror.WrapWith(err, "getting resource failed", "name", pvcName)
+	}
+
+	switch {
+	case apierrors.IsNotFound(err) && desiredState == k8sutils.DesiredStatePresent:
+		desired, err := r.persistentVolumeClaim()
+		if err != nil {
+			return err
+		}
+		err = r.Client.Create(context.TODO(), desired)
+		if err != nil {
+			return emperror.WrapWith(err, "creating resource failed", "name", pvcName)
+		}
+		log.Info("resource created", "kind", "PersistentVolumeClaim", "name", pvcName)
+		metrics.RecordResourceOperation("PersistentVolumeClaim", metrics.OperationCreated)
+		k8sutils.RecordResourceEvent(r.Client, k8sutils.EventReasonCreated, "PersistentVolumeClaim", r.Config.Namespace, pvcName)
+	case err == nil && desiredState == k8sutils.DesiredStateAbsent:
+		err = r.Client.Delete(context.TODO(), &current)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return emperror.WrapWith(err, "deleting resource failed", "name", pvcName)
+		}
+		log.Info("resource deleted", "kind", "PersistentVolumeClaim", "name", pvcName)
+		metrics.RecordResourceOperation("PersistentVolumeClaim", metrics.OperationDeleted)
+		k8sutils.RecordResourceEvent(r.Client, k8sutils.EventReasonDeleted, "PersistentVolumeClaim", r.Config.Namespace, pvcName)
+	}
+
+	return nil
+}
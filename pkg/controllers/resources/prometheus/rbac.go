@@ -0,0 +1,55 @@
+package prometheus
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/symcn/mid-operator/pkg/controllers/resources/templates"
+)
+
+func (r *Reconciler) serviceAccount() runtime.Object {
+	return &apiv1.ServiceAccount{
+		ObjectMeta: templates.ObjectMeta(serviceAccountName, labels, r.Config),
+	}
+}
+
+func (r *Reconciler) clusterRole() runtime.Object {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: templates.ObjectMetaClusterScope(clusterRoleName+"-"+r.Config.Namespace, labels, r.Config),
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes", "nodes/proxy", "services", "endpoints", "pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"configmaps"},
+				Verbs:     []string{"get"},
+			},
+			{
+				NonResourceURLs: []string{"/metrics"},
+				Verbs:           []string{"get"},
+			},
+		},
+	}
+}
+
+func (r *Reconciler) clusterRoleBinding() runtime.Object {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: templates.ObjectMetaClusterScope(clusterRoleBindingName+"-"+r.Config.Namespace, labels, r.Config),
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "ClusterRole",
+			APIGroup: "rbac.authorization.k8s.io",
+			Name:     clusterRoleName + "-" + r.Config.Namespace,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      serviceAccountName,
+				Namespace: r.Config.Namespace,
+			},
+		},
+	}
+}
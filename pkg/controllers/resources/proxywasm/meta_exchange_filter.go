@@ -6,6 +6,7 @@ import (
 	"github.com/ghodss/yaml"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 	"github.com/symcn/mid-operator/pkg/k8sutils"
 	"github.com/symcn/mid-operator/pkg/utils"
 )
@@ -16,7 +17,7 @@ const (
   match:
     context: ANY # inbound, outbound, and gateway
     proxy:
-      proxyVersion: '^1\.5.*'
+      proxyVersion: '%[3]s'
     listener:
       filterChain:
         filter:
@@ -34,15 +35,15 @@ const (
             vm_config:
               code:
                 local:
-                  %s
-              runtime: %s
+                  %[1]s
+              runtime: %[2]s
 `
 	TCPMetadataExchangeFilterYAML = `
     - applyTo: NETWORK_FILTER
       match:
         context: SIDECAR_INBOUND
         proxy:
-          proxyVersion: '^1\.5.*'
+          proxyVersion: '%[1]s'
         listener: {}
       patch:
         operation: INSERT_BEFORE
@@ -54,7 +55,7 @@ const (
       match:
         context: SIDECAR_OUTBOUND
         proxy:
-          proxyVersion: '^1\.5.*'
+          proxyVersion: '%[1]s'
         cluster: {}
       patch:
         operation: MERGE
@@ -70,7 +71,7 @@ const (
       match:
         context: GATEWAY
         proxy:
-          proxyVersion: '^1\.5.*'
+          proxyVersion: '%[1]s'
         cluster: {}
       patch:
         operation: MERGE
@@ -87,7 +88,7 @@ const (
 	metaExchangeNoWasmLocal = "inline_string: envoy.wasm.metadata_exchange"
 )
 
-func (r *Reconciler) metaexchangeEnvoyFilter() *k8sutils.DynamicObject {
+func (r *Reconciler) metaexchangeEnvoyFilter(version devopsv1beta1.IstioVersion) *k8sutils.DynamicObject {
 
 	wasmEnabled := utils.PointerToBool(r.Config.Spec.ProxyWasm.Enabled)
 
@@ -99,7 +100,7 @@ func (r *Reconciler) metaexchangeEnvoyFilter() *k8sutils.DynamicObject {
 	}
 
 	var y []map[string]interface{}
-	yaml.Unmarshal([]byte(fmt.Sprintf(metadataExchangeFilterYAML, vmConfigLocal, vmConfigRuntime)), &y)
+	yaml.Unmarshal([]byte(fmt.Sprintf(metadataExchangeFilterYAML, vmConfigLocal, vmConfigRuntime, version.ProxyVersionRegex())), &y)
 
 	return &k8sutils.DynamicObject{
 		Gvr: schema.GroupVersionResource{
@@ -108,7 +109,7 @@ func (r *Reconciler) metaexchangeEnvoyFilter() *k8sutils.DynamicObject {
 			Resource: "envoyfilters",
 		},
 		Kind:      "EnvoyFilter",
-		Name:      componentName + "-metadata-exchange",
+		Name:      filterName("metadata-exchange", version),
 		Namespace: r.Config.Namespace,
 		Spec: map[string]interface{}{
 			"configPatches": y,
@@ -117,9 +118,9 @@ func (r *Reconciler) metaexchangeEnvoyFilter() *k8sutils.DynamicObject {
 	}
 }
 
-func (r *Reconciler) TCPMetaexchangeEnvoyFilter() *k8sutils.DynamicObject {
+func (r *Reconciler) TCPMetaexchangeEnvoyFilter(version devopsv1beta1.IstioVersion) *k8sutils.DynamicObject {
 	var y []map[string]interface{}
-	yaml.Unmarshal([]byte(TCPMetadataExchangeFilterYAML), &y)
+	yaml.Unmarshal([]byte(fmt.Sprintf(TCPMetadataExchangeFilterYAML, version.ProxyVersionRegex())), &y)
 
 	return &k8sutils.DynamicObject{
 		Gvr: schema.GroupVersionResource{
@@ -128,7 +129,7 @@ func (r *Reconciler) TCPMetaexchangeEnvoyFilter() *k8sutils.DynamicObject {
 			Resource: "envoyfilters",
 		},
 		Kind:      "EnvoyFilter",
-		Name:      componentName + "-tcp-metadata-exchange",
+		Name:      filterName("tcp-metadata-exchange", version),
 		Namespace: r.Config.Namespace,
 		Spec: map[string]interface{}{
 			"configPatches": y,
@@ -1,8 +1,11 @@
 package proxywasm
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 	"github.com/goph/emperror"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -33,21 +36,52 @@ func New(client client.Client, dc dynamic.Interface, config *devopsv1beta1.Istio
 }
 
 func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, false)
+}
+
+// Cleanup removes the filters of every supported version
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, true)
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, teardown bool) error {
 	log = log.WithValues("component", componentName)
 	log.Info("Reconciling")
 
-	exchangeFilterDesiredState := k8sutils.DesiredStatePresent
-	statsFilterDesiredState := k8sutils.DesiredStatePresent
-	drs := []resources.DynamicResourceWithDesiredState{
-		{DynamicResource: r.metaexchangeEnvoyFilter, DesiredState: exchangeFilterDesiredState},
-		{DynamicResource: r.TCPMetaexchangeEnvoyFilter, DesiredState: exchangeFilterDesiredState},
-		{DynamicResource: r.httpStatsFsilter, DesiredState: statsFilterDesiredState},
-		{DynamicResource: r.tcpStatsFilter, DesiredState: statsFilterDesiredState},
+	// the filters of the previous versions are kept for the proxies not restarted since an upgrade,
+	// the ones of the istiod revisions for the proxies injected by them
+	pending := map[string]bool{r.Config.Spec.Version.MinorVersion(): true}
+	for _, revision := range r.Config.Spec.Istiod.Revisions {
+		pending[revision.Version.MinorVersion()] = true
+	}
+	desiredState := k8sutils.DesiredStatePresent
+	if teardown {
+		desiredState = k8sutils.DesiredStateAbsent
+	}
+	for _, version := range devopsv1beta1.SupportedIstioMinorVersions() {
+		for _, res := range []func(devopsv1beta1.IstioVersion) *k8sutils.DynamicObject{
+			r.metaexchangeEnvoyFilter,
+			r.TCPMetaexchangeEnvoyFilter,
+			r.httpStatsFsilter,
+			r.tcpStatsFilter,
+		} {
+			o := res(devopsv1beta1.IstioVersion(version))
+			err := o.Reconcile(log, r.dynamic, desiredState)
+			if err != nil {
+				return emperror.WrapWith(err, "failed to reconcile dynamic resource", "resource", o.Gvr, "version", version)
+			}
+		}
+
+		delete(pending, version)
+		if len(pending) == 0 {
+			desiredState = k8sutils.DesiredStateAbsent
+		}
 	}
 
-	for _, dr := range drs {
-		o := dr.DynamicResource()
-		err := o.Reconcile(log, r.dynamic, dr.DesiredState)
+	// filters created before they were versioned
+	for _, name := range []string{"metadata-exchange", "tcp-metadata-exchange", "stats", "tcp-stats"} {
+		o := r.legacyEnvoyFilter(name)
+		err := o.Reconcile(log, r.dynamic, k8sutils.DesiredStateAbsent)
 		if err != nil {
 			return emperror.WrapWith(err, "failed to reconcile dynamic resource", "resource", o.Gvr)
 		}
@@ -57,3 +91,21 @@ func (r *Reconciler) Reconcile(log logr.Logger) error {
 
 	return nil
 }
+
+func filterName(name string, version devopsv1beta1.IstioVersion) string {
+	return fmt.Sprintf("%s-%s-%s", componentName, name, version.MinorVersion())
+}
+
+func (r *Reconciler) legacyEnvoyFilter(name string) *k8sutils.DynamicObject {
+	return &k8sutils.DynamicObject{
+		Gvr: schema.GroupVersionResource{
+			Group:    "networking.istio.io",
+			Version:  "v1alpha3",
+			Resource: "envoyfilters",
+		},
+		Kind:      "EnvoyFilter",
+		Name:      componentName + "-" + name,
+		Namespace: r.Config.Namespace,
+		Owner:     r.Config,
+	}
+}
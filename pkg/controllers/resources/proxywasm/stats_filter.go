@@ -6,6 +6,7 @@ import (
 	"github.com/ghodss/yaml"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 	"github.com/symcn/mid-operator/pkg/k8sutils"
 	"github.com/symcn/mid-operator/pkg/utils"
 )
@@ -16,7 +17,7 @@ const (
   match:
     context: SIDECAR_OUTBOUND
     proxy:
-      proxyVersion: '^1\.5.*'
+      proxyVersion: '%[3]s'
     listener:
       filterChain:
         filter:
@@ -48,7 +49,7 @@ const (
   match:
     context: SIDECAR_INBOUND
     proxy:
-      proxyVersion: '^1\.5.*'
+      proxyVersion: '%[3]s'
     listener:
       filterChain:
         filter:
@@ -80,7 +81,7 @@ const (
   match:
     context: GATEWAY
     proxy:
-      proxyVersion: '^1\.5.*'
+      proxyVersion: '%[3]s'
     listener:
       filterChain:
         filter:
@@ -118,7 +119,7 @@ const (
         filter:
           name: envoy.tcp_proxy
     proxy:
-      proxyVersion: 1\.5.*
+      proxyVersion: '%[3]s'
   patch:
     operation: INSERT_BEFORE
     value:
@@ -148,7 +149,7 @@ const (
         filter:
           name: envoy.tcp_proxy
     proxy:
-      proxyVersion: 1\.5.*
+      proxyVersion: '%[3]s'
   patch:
     operation: INSERT_BEFORE
     value:
@@ -178,7 +179,7 @@ const (
         filter:
           name: envoy.tcp_proxy
     proxy:
-      proxyVersion: 1\.5.*
+      proxyVersion: '%[3]s'
   patch:
     operation: INSERT_BEFORE
     value:
@@ -205,7 +206,7 @@ const (
 	statsNoWasmLocal = "inline_string: envoy.wasm.stats"
 )
 
-func (r *Reconciler) httpStatsFsilter() *k8sutils.DynamicObject {
+func (r *Reconciler) httpStatsFsilter(version devopsv1beta1.IstioVersion) *k8sutils.DynamicObject {
 
 	wasmEnabled := utils.PointerToBool(r.Config.Spec.ProxyWasm.Enabled)
 
@@ -217,7 +218,7 @@ func (r *Reconciler) httpStatsFsilter() *k8sutils.DynamicObject {
 	}
 
 	var y []map[string]interface{}
-	yaml.Unmarshal([]byte(fmt.Sprintf(httpStatsFilterYAML, vmConfigLocal, vmConfigRuntime)), &y)
+	yaml.Unmarshal([]byte(fmt.Sprintf(httpStatsFilterYAML, vmConfigLocal, vmConfigRuntime, version.ProxyVersionRegex())), &y)
 
 	return &k8sutils.DynamicObject{
 		Gvr: schema.GroupVersionResource{
@@ -226,7 +227,7 @@ func (r *Reconciler) httpStatsFsilter() *k8sutils.DynamicObject {
 			Resource: "envoyfilters",
 		},
 		Kind:      "EnvoyFilter",
-		Name:      componentName + "-stats",
+		Name:      filterName("stats", version),
 		Namespace: r.Config.Namespace,
 		Spec: map[string]interface{}{
 			"configPatches": y,
@@ -235,7 +236,7 @@ func (r *Reconciler) httpStatsFsilter() *k8sutils.DynamicObject {
 	}
 }
 
-func (r *Reconciler) tcpStatsFilter() *k8sutils.DynamicObject {
+func (r *Reconciler) tcpStatsFilter(version devopsv1beta1.IstioVersion) *k8sutils.DynamicObject {
 
 	wasmEnabled := utils.PointerToBool(r.Config.Spec.ProxyWasm.Enabled)
 
@@ -247,7 +248,7 @@ func (r *Reconciler) tcpStatsFilter() *k8sutils.DynamicObject {
 	}
 
 	var y []map[string]interface{}
-	yaml.Unmarshal([]byte(fmt.Sprintf(tcpStatsFilterYAML, vmConfigLocal, vmConfigRuntime)), &y)
+	yaml.Unmarshal([]byte(fmt.Sprintf(tcpStatsFilterYAML, vmConfigLocal, vmConfigRuntime, version.ProxyVersionRegex())), &y)
 
 	return &k8sutils.DynamicObject{
 		Gvr: schema.GroupVersionResource{
@@ -256,7 +257,7 @@ func (r *Reconciler) tcpStatsFilter() *k8sutils.DynamicObject {
 			Resource: "envoyfilters",
 		},
 		Kind:      "EnvoyFilter",
-		Name:      componentName + "-tcp-stats",
+		Name:      filterName("tcp-stats", version),
 		Namespace: r.Config.Namespace,
 		Spec: map[string]interface{}{
 			"configPatches": y,
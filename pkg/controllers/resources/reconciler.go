@@ -1,14 +1,20 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/metrics"
 	"github.com/symcn/mid-operator/pkg/utils"
 )
 
@@ -21,6 +27,52 @@ type ComponentReconciler interface {
 	Reconcile(log logr.Logger) error
 }
 
+// Component couples a component reconciler with the status condition it reports into
+type Component struct {
+	ConditionType devopsv1beta1.ConditionType
+	Reconciler    ComponentReconciler
+	// DependsOn are the components that have to be reconciled successfully before this one
+	DependsOn []devopsv1beta1.ConditionType
+}
+
+// ReconcileComponent runs the reconciler of the component and reports its outcome into the condition of the component
+func ReconcileComponent(log logr.Logger, component Component, conditions *[]devopsv1beta1.Condition, generation int64) error {
+	err := reconcileComponent(log, component)
+	devopsv1beta1.SetCondition(conditions, componentCondition(component, err, generation))
+
+	return err
+}
+
+func reconcileComponent(log logr.Logger, component Component) error {
+	start := time.Now()
+	err := component.Reconciler.Reconcile(log)
+	metrics.ObserveComponentReconcile(string(component.ConditionType), time.Since(start), err)
+
+	return err
+}
+
+func componentCondition(component Component, err error, generation int64) devopsv1beta1.Condition {
+	condition := devopsv1beta1.Condition{
+		Type:               component.ConditionType,
+		Status:             corev1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             devopsv1beta1.ConditionReasonReconciled,
+	}
+	if err != nil {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = devopsv1beta1.ConditionReasonReconcileFailed
+		condition.Message = err.Error()
+	}
+
+	return condition
+}
+
+// ComponentCleaner is implemented by the component reconcilers able to remove every resource they created,
+// including the ones owner references cannot clean up
+type ComponentCleaner interface {
+	Cleanup(log logr.Logger) error
+}
+
 type Resource func() runtime.Object
 
 type ResourceVariation func(t string) runtime.Object
@@ -83,3 +135,18 @@ func GetDiscoveryAddress(in *devopsv1beta1.Istio, svcNames ...string) string {
 	}
 	return fmt.Sprintf("%s.%s.svc:%s", svcName, in.Namespace, GetDiscoveryPort(in))
 }
+
+// GetRemoteIstios returns the RemoteIstios attached to the Istio control plane, sorted by name
+func GetRemoteIstios(c client.Client, config *devopsv1beta1.Istio) ([]devopsv1beta1.RemoteIstio, error) {
+	var remoteIstios devopsv1beta1.RemoteIstioList
+	err := c.List(context.TODO(), &remoteIstios, client.InNamespace(config.Namespace))
+	if err != nil {
+		return nil, emperror.Wrap(err, "could not list remote istio resources")
+	}
+
+	sort.Slice(remoteIstios.Items, func(i, j int) bool {
+		return remoteIstios.Items[i].Name < remoteIstios.Items[j].Name
+	})
+
+	return remoteIstios.Items, nil
+}
@@ -0,0 +1,123 @@
+package remote
+
+import (
+	"context"
+	"sort"
+
+	"github.com/goph/emperror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+// objects on the remote side can not be owned by the RemoteIstio which only exists on the primary cluster,
+// they are marked with the created-by label instead
+func (r *Reconciler) objectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: r.Config.Namespace,
+		Labels: utils.MergeStringMaps(remoteLabels, map[string]string{
+			utils.CreatedByLabel: utils.CreatedBy,
+		}),
+	}
+}
+
+func (r *Reconciler) namespace() runtime.Object {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: r.Config.Namespace,
+		},
+	}
+}
+
+func (r *Reconciler) caSecret() runtime.Object {
+	return &corev1.Secret{
+		ObjectMeta: r.objectMeta(caSecretName),
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"ca-cert.pem":    r.remoteConfig.Spec.SignCert.CA,
+			"ca-key.pem":     r.remoteConfig.Spec.SignCert.Key,
+			"root-cert.pem":  r.remoteConfig.Spec.SignCert.Root,
+			"cert-chain.pem": r.remoteConfig.Spec.SignCert.Chain,
+		},
+	}
+}
+
+func (r *Reconciler) service(svc devopsv1beta1.IstioService) runtime.Object {
+	return &corev1.Service{
+		ObjectMeta: r.objectMeta(svc.Name),
+		Spec: corev1.ServiceSpec{
+			Ports: svc.Ports,
+			Type:  corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
+func (r *Reconciler) endpoints(svc devopsv1beta1.IstioService, ips []string) runtime.Object {
+	ports := make([]corev1.EndpointPort, 0, len(svc.Ports))
+	for _, port := range svc.Ports {
+		targetPort := port.Port
+		if port.TargetPort.IntValue() > 0 {
+			targetPort = int32(port.TargetPort.IntValue())
+		}
+		ports = append(ports, corev1.EndpointPort{
+			Name:     port.Name,
+			Port:     targetPort,
+			Protocol: port.Protocol,
+		})
+	}
+
+	addresses := make([]corev1.EndpointAddress, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, corev1.EndpointAddress{IP: ip})
+	}
+
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: r.objectMeta(svc.Name),
+	}
+	if len(addresses) > 0 {
+		endpoints.Subsets = []corev1.EndpointSubset{
+			{
+				Addresses: addresses,
+				Ports:     ports,
+			},
+		}
+	}
+
+	return endpoints
+}
+
+// serviceIPs returns the IPs of the running pods on the primary cluster selected by the label selector of the service,
+// or the explicitly configured IPs when no selector is given
+func (r *Reconciler) serviceIPs(svc devopsv1beta1.IstioService) ([]string, error) {
+	if svc.LabelSelector == "" {
+		return svc.IPs, nil
+	}
+
+	selector, err := labels.Parse(svc.LabelSelector)
+	if err != nil {
+		return nil, emperror.WrapWith(err, "invalid label selector", "selector", svc.LabelSelector)
+	}
+
+	var pods corev1.PodList
+	err = r.localClient.List(context.TODO(), &pods, client.InNamespace(r.Config.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, emperror.Wrap(err, "could not list pods")
+	}
+
+	ips := make([]string, 0)
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+		ips = append(ips, pod.Status.PodIP)
+	}
+	sort.Strings(ips)
+
+	return ips, nil
+}
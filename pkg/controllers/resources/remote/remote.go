@@ -0,0 +1,87 @@
+package remote
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+)
+
+const (
+	componentName = "remote"
+	caSecretName  = "cacerts"
+)
+
+var remoteLabels = map[string]string{
+	"app": "istio-remote",
+}
+
+// Reconciler installs the remote side of a primary/remote mesh into the remote cluster.
+// The embedded client talks to the remote cluster, localClient to the primary one.
+type Reconciler struct {
+	resources.Reconciler
+	localClient  client.Client
+	remoteConfig *devopsv1beta1.RemoteIstio
+}
+
+func New(localClient, remoteClient client.Client, config *devopsv1beta1.Istio, remoteConfig *devopsv1beta1.RemoteIstio) *Reconciler {
+	return &Reconciler{
+		Reconciler: resources.Reconciler{
+			Client: remoteClient,
+			Config: config,
+		},
+		localClient:  localClient,
+		remoteConfig: remoteConfig,
+	}
+}
+
+func (r *Reconciler) Reconcile(log logr.Logger) error {
+	log = log.WithValues("component", componentName)
+
+	log.Info("Reconciling")
+
+	err := k8sutils.Reconcile(log, r.Client, r.namespace(), k8sutils.DesiredStatePresent)
+	if err != nil {
+		return emperror.WrapWith(err, "failed to reconcile namespace", "namespace", r.Config.Namespace)
+	}
+
+	desiredState := k8sutils.DesiredStatePresent
+	if !r.hasSignCert() {
+		desiredState = k8sutils.DesiredStateAbsent
+	}
+	o := r.caSecret()
+	err = k8sutils.Reconcile(log, r.Client, o, desiredState)
+	if err != nil {
+		return emperror.WrapWith(err, "failed to reconcile resource", "resource", o.GetObjectKind().GroupVersionKind())
+	}
+
+	for _, svc := range r.remoteConfig.Spec.EnabledServices {
+		ips, err := r.serviceIPs(svc)
+		if err != nil {
+			return emperror.WrapWith(err, "failed to get service endpoints", "service", svc.Name)
+		}
+
+		for _, o := range []runtime.Object{
+			r.service(svc),
+			r.endpoints(svc, ips),
+		} {
+			err := k8sutils.Reconcile(log, r.Client, o, k8sutils.DesiredStatePresent)
+			if err != nil {
+				return emperror.WrapWith(err, "failed to reconcile resource", "resource", o.GetObjectKind().GroupVersionKind(), "service", svc.Name)
+			}
+		}
+	}
+
+	log.Info("Reconciled")
+
+	return nil
+}
+
+func (r *Reconciler) hasSignCert() bool {
+	c := r.remoteConfig.Spec.SignCert
+	return len(c.CA) > 0 && len(c.Key) > 0 && len(c.Root) > 0 && len(c.Chain) > 0
+}
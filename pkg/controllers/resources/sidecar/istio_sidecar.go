@@ -0,0 +1,75 @@
+package sidecar
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+)
+
+func (r *Reconciler) istioSidecar() *k8sutils.DynamicObject {
+	spec := map[string]interface{}{}
+
+	if len(r.sidecar.Spec.WorkloadSelector) > 0 {
+		spec["workloadSelector"] = map[string]interface{}{
+			"labels": r.sidecar.Spec.WorkloadSelector,
+		}
+	}
+
+	if len(r.sidecar.Spec.InboundPorts) > 0 {
+		ingress := make([]interface{}, 0, len(r.sidecar.Spec.InboundPorts))
+		for _, port := range r.sidecar.Spec.InboundPorts {
+			listener := map[string]interface{}{
+				"port": sidecarPort(port),
+			}
+			if port.DefaultEndpoint != "" {
+				listener["defaultEndpoint"] = port.DefaultEndpoint
+			}
+			ingress = append(ingress, listener)
+		}
+		spec["ingress"] = ingress
+	}
+
+	if len(r.sidecar.Spec.EgressHosts) > 0 {
+		egress := make([]interface{}, 0)
+		if len(r.sidecar.Spec.OutboundPorts) == 0 {
+			egress = append(egress, map[string]interface{}{
+				"hosts": r.sidecar.Spec.EgressHosts,
+			})
+		}
+		for _, port := range r.sidecar.Spec.OutboundPorts {
+			egress = append(egress, map[string]interface{}{
+				"port":  sidecarPort(port),
+				"hosts": r.sidecar.Spec.EgressHosts,
+			})
+		}
+		spec["egress"] = egress
+	}
+
+	return &k8sutils.DynamicObject{
+		Gvr: schema.GroupVersionResource{
+			Group:    "networking.istio.io",
+			Version:  "v1alpha3",
+			Resource: "sidecars",
+		},
+		Kind:      "Sidecar",
+		Name:      r.sidecar.Name,
+		Namespace: r.sidecar.Namespace,
+		Spec:      spec,
+		Owner:     r.sidecar,
+	}
+}
+
+func sidecarPort(port devopsv1beta1.SidecarPort) map[string]interface{} {
+	p := map[string]interface{}{
+		"number": port.Number,
+	}
+	if port.Protocol != "" {
+		p["protocol"] = port.Protocol
+	}
+	if port.Name != "" {
+		p["name"] = port.Name
+	}
+
+	return p
+}
@@ -0,0 +1,82 @@
+package sidecar
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+)
+
+const (
+	componentName = "sidecar"
+)
+
+// Reconciler renders a Sidecar policy into an Istio Sidecar resource
+// and into the pod annotations of the selected workloads
+type Reconciler struct {
+	client.Client
+	dynamic dynamic.Interface
+	sidecar *devopsv1beta1.Sidecar
+}
+
+func New(client client.Client, dc dynamic.Interface, sidecar *devopsv1beta1.Sidecar) *Reconciler {
+	return &Reconciler{
+		Client:  client,
+		dynamic: dc,
+		sidecar: sidecar,
+	}
+}
+
+func (r *Reconciler) Reconcile(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStatePresent)
+}
+
+// Cleanup removes the Istio Sidecar resource and the settings of the Sidecar policy from the workloads
+func (r *Reconciler) Cleanup(log logr.Logger) error {
+	return r.reconcile(log, k8sutils.DesiredStateAbsent)
+}
+
+// Workloads returns the names of the workloads the settings are applied to
+// and of the selected ones the settings of another Sidecar are applied to
+func (r *Reconciler) Workloads() ([]string, []string, error) {
+	selected, conflicting, err := r.selectedDeployments()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deploymentNames(selected), deploymentNames(conflicting), nil
+}
+
+func deploymentNames(deployments []appsv1.Deployment) []string {
+	names := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		names = append(names, deployment.Name)
+	}
+
+	return names
+}
+
+func (r *Reconciler) reconcile(log logr.Logger, desiredState k8sutils.DesiredState) error {
+	log = log.WithValues("component", componentName, "sidecar", r.sidecar.Name)
+
+	log.Info("Reconciling")
+
+	o := r.istioSidecar()
+	err := o.Reconcile(log, r.dynamic, desiredState)
+	if err != nil {
+		return emperror.WrapWith(err, "failed to reconcile dynamic resource", "resource", o.Gvr)
+	}
+
+	err = r.reconcileWorkloads(log, desiredState)
+	if err != nil {
+		return emperror.Wrap(err, "failed to reconcile workloads")
+	}
+
+	log.Info("Reconciled")
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+)
+
+const (
+	// SidecarAnnotation marks the workloads the settings of a Sidecar policy are applied to
+	SidecarAnnotation = "devops.symcn.com/sidecar"
+	// appliedAnnotationsAnnotation lists the pod annotations set by the Sidecar policy,
+	// the ones set by the owner of the workload are neither overwritten nor removed
+	appliedAnnotationsAnnotation = "devops.symcn.com/sidecar-annotations"
+
+	proxyCPUAnnotation            = "sidecar.istio.io/proxyCPU"
+	proxyMemoryAnnotation         = "sidecar.istio.io/proxyMemory"
+	proxyCPULimitAnnotation       = "sidecar.istio.io/proxyCPULimit"
+	proxyMemoryLimitAnnotation    = "sidecar.istio.io/proxyMemoryLimit"
+	logLevelAnnotation            = "sidecar.istio.io/logLevel"
+	proxyConfigAnnotation         = "proxy.istio.io/config"
+	includeInboundPortsAnnotation = "traffic.sidecar.istio.io/includeInboundPorts"
+)
+
+// managedAnnotations are the annotations a Sidecar policy may set, the ones of the workloads configured before
+// the applied annotations were recorded
+var managedAnnotations = []string{
+	proxyCPUAnnotation,
+	proxyMemoryAnnotation,
+	proxyCPULimitAnnotation,
+	proxyMemoryLimitAnnotation,
+	logLevelAnnotation,
+	proxyConfigAnnotation,
+	includeInboundPortsAnnotation,
+}
+
+// podAnnotations returns the sidecar injection annotations of the policy
+func (r *Reconciler) podAnnotations() map[string]string {
+	annotations := make(map[string]string)
+
+	if resources := r.sidecar.Spec.Resources; resources != nil {
+		if cpu, ok := resources.Requests[corev1.ResourceCPU]; ok {
+			annotations[proxyCPUAnnotation] = cpu.String()
+		}
+		if memory, ok := resources.Requests[corev1.ResourceMemory]; ok {
+			annotations[proxyMemoryAnnotation] = memory.String()
+		}
+		if cpu, ok := resources.Limits[corev1.ResourceCPU]; ok {
+			annotations[proxyCPULimitAnnotation] = cpu.String()
+		}
+		if memory, ok := resources.Limits[corev1.ResourceMemory]; ok {
+			annotations[proxyMemoryLimitAnnotation] = memory.String()
+		}
+	}
+
+	if r.sidecar.Spec.LogLevel != "" {
+		annotations[logLevelAnnotation] = r.sidecar.Spec.LogLevel
+	}
+
+	if r.sidecar.Spec.Concurrency != nil {
+		annotations[proxyConfigAnnotation] = fmt.Sprintf("concurrency: %d", *r.sidecar.Spec.Concurrency)
+	}
+
+	if len(r.sidecar.Spec.InboundPorts) > 0 {
+		ports := make([]string, 0, len(r.sidecar.Spec.InboundPorts))
+		for _, port := range r.sidecar.Spec.InboundPorts {
+			ports = append(ports, strconv.Itoa(int(port.Number)))
+		}
+		annotations[includeInboundPortsAnnotation] = strings.Join(ports, ",")
+	}
+
+	return annotations
+}
+
+func (r *Reconciler) selector() labels.Selector {
+	return labels.SelectorFromSet(r.sidecar.Spec.WorkloadSelector)
+}
+
+// selectedDeployments returns the selected deployments the settings are applied to
+// and the ones configured by another Sidecar
+func (r *Reconciler) selectedDeployments() ([]appsv1.Deployment, []appsv1.Deployment, error) {
+	var deployments appsv1.DeploymentList
+	err := r.Client.List(context.TODO(), &deployments, client.InNamespace(r.sidecar.Namespace))
+	if err != nil {
+		return nil, nil, emperror.Wrap(err, "could not list deployments")
+	}
+
+	selected := make([]appsv1.Deployment, 0)
+	conflicting := make([]appsv1.Deployment, 0)
+	for _, deployment := range deployments.Items {
+		if !r.selector().Matches(labels.Set(deployment.Spec.Template.Labels)) {
+			continue
+		}
+		if r.ownedByOther(&deployment) {
+			conflicting = append(conflicting, deployment)
+			continue
+		}
+		selected = append(selected, deployment)
+	}
+
+	return selected, conflicting, nil
+}
+
+// ownedByOther returns whether the settings of another Sidecar are applied to the deployment
+func (r *Reconciler) ownedByOther(deployment *appsv1.Deployment) bool {
+	owner := deployment.Spec.Template.Annotations[SidecarAnnotation]
+	return owner != "" && owner != r.sidecar.Name
+}
+
+// appliedAnnotations returns the annotations the policy set on the pod template
+func appliedAnnotations(annotations map[string]string) []string {
+	applied, ok := annotations[appliedAnnotationsAnnotation]
+	if !ok {
+		return managedAnnotations
+	}
+	if applied == "" {
+		return nil
+	}
+
+	return strings.Split(applied, ",")
+}
+
+// reconcileWorkloads sets the pod annotations on the selected deployments and removes them
+// from the deployments the policy no longer applies to. The deployments configured by another Sidecar
+// are left alone, and so are the annotations set by the owner of the workload.
+func (r *Reconciler) reconcileWorkloads(log logr.Logger, desiredState k8sutils.DesiredState) error {
+	var deployments appsv1.DeploymentList
+	err := r.Client.List(context.TODO(), &deployments, client.InNamespace(r.sidecar.Namespace))
+	if err != nil {
+		return emperror.Wrap(err, "could not list deployments")
+	}
+
+	desired := r.podAnnotations()
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		current := deployment.Spec.Template.Annotations
+		owned := current[SidecarAnnotation] == r.sidecar.Name
+		selected := desiredState == k8sutils.DesiredStatePresent && r.selector().Matches(labels.Set(deployment.Spec.Template.Labels))
+		if r.ownedByOther(deployment) {
+			if selected {
+				log.Info("workload is configured by another sidecar", "deployment", deployment.Name, "sidecar", current[SidecarAnnotation])
+			}
+			continue
+		}
+		if !selected && !owned {
+			continue
+		}
+
+		annotations := make(map[string]string)
+		for k, v := range current {
+			annotations[k] = v
+		}
+		if owned {
+			for _, k := range appliedAnnotations(current) {
+				delete(annotations, k)
+			}
+			delete(annotations, SidecarAnnotation)
+			delete(annotations, appliedAnnotationsAnnotation)
+		}
+		if selected {
+			applied := make([]string, 0, len(desired))
+			for k, v := range desired {
+				if _, ok := annotations[k]; ok {
+					// set by the owner of the workload
+					continue
+				}
+				annotations[k] = v
+				applied = append(applied, k)
+			}
+			sort.Strings(applied)
+			annotations[SidecarAnnotation] = r.sidecar.Name
+			annotations[appliedAnnotationsAnnotation] = strings.Join(applied, ",")
+		}
+
+		if reflect.DeepEqual(annotations, current) || (len(annotations) == 0 && len(current) == 0) {
+			continue
+		}
+
+		deployment.Spec.Template.Annotations = annotations
+		err := r.Client.Update(context.TODO(), deployment)
+		if err != nil {
+			return emperror.WrapWith(err, "could not update deployment", "deployment", deployment.Name)
+		}
+		log.Info("workload annotations updated", "deployment", deployment.Name)
+	}
+
+	return nil
+}
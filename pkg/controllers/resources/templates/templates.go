@@ -12,6 +12,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// meshNetworkGatewayPort is the port of the ingress gateway service the cross network traffic is sent to
+const meshNetworkGatewayPort = 443
+
 type MeshNetworkEndpoint struct {
 	FromCIDR     string `json:"fromCidr,omitempty"`
 	FromRegistry string `json:"fromRegistry,omitempty"`
@@ -31,7 +34,7 @@ type MeshNetworks struct {
 	Networks map[string]*MeshNetwork `json:"networks"`
 }
 
-func GetMeshNetworks(config *devopsv1beta1.Istio) *MeshNetworks {
+func GetMeshNetworks(config *devopsv1beta1.Istio, remoteIstios []devopsv1beta1.RemoteIstio) *MeshNetworks {
 	meshNetworks := make(map[string]*MeshNetwork)
 
 	localNetwork := &MeshNetwork{
@@ -43,46 +46,62 @@ func GetMeshNetworks(config *devopsv1beta1.Istio) *MeshNetworks {
 	}
 
 	if len(config.Status.GatewayAddress) > 0 {
-		gateways := make([]*MeshNetworkGateway, 0)
-		for _, address := range config.Status.GatewayAddress {
-			gateways = append(gateways, &MeshNetworkGateway{
-				Address: address, Port: 443,
-			})
-		}
-		localNetwork.Gateways = gateways
+		localNetwork.Gateways = getMeshNetworkGateways(config.Status.GatewayEndpoints, config.Status.GatewayAddress)
 	}
 
 	meshNetworks[config.Spec.NetworkName] = localNetwork
 
-	// remoteIstios := remoteistioCtrl.GetRemoteIstiosByOwnerReference(r.Mgr, config, logger)
-	// for _, remoteIstio := range remoteIstios {
-	// 	gateways := make([]devopsv1beta1.MeshNetworkGateway, 0)
-	// 	if len(remoteIstio.Status.GatewayAddress) > 0 {
-	// 		for _, address := range remoteIstio.Status.GatewayAddress {
-	// 			gateways = append(gateways, istiov1beta1.MeshNetworkGateway{
-	// 				Address: address, Port: 443,
-	// 			})
-	// 		}
-	// 	} else {
-	// 		continue
-	// 	}
-	//
-	// 	meshNetworks[remoteIstio.Name] = devopsv1beta1.MeshNetwork{
-	// 		Endpoints: []istiov1beta1.MeshNetworkEndpoint{
-	// 			{
-	// 				FromRegistry: remoteIstio.Name,
-	// 			},
-	// 		},
-	// 		Gateways: gateways,
-	// 	}
-	// }
+	for _, remoteIstio := range remoteIstios {
+		if len(remoteIstio.Status.GatewayAddress) == 0 {
+			continue
+		}
+
+		meshNetworks[remoteIstio.Name] = &MeshNetwork{
+			Endpoints: []*MeshNetworkEndpoint{
+				{
+					FromRegistry: remoteIstio.Name,
+				},
+			},
+			Gateways: getMeshNetworkGateways(remoteIstio.Status.GatewayEndpoints, remoteIstio.Status.GatewayAddress),
+		}
+	}
 
 	return &MeshNetworks{Networks: meshNetworks}
 }
 
-func GetMeshNetworksHash(config *devopsv1beta1.Istio) string {
+// getMeshNetworkGateways returns the gateways of a network, the port the gateway service is exposed on at each
+// endpoint is used when known, addresses reported without their endpoints are reached on the service port
+func getMeshNetworkGateways(endpoints []devopsv1beta1.GatewayEndpoint, addresses []string) []*MeshNetworkGateway {
+	gateways := make([]*MeshNetworkGateway, 0)
+	if len(endpoints) == 0 {
+		for _, address := range addresses {
+			gateways = append(gateways, &MeshNetworkGateway{
+				Address: address, Port: meshNetworkGatewayPort,
+			})
+		}
+
+		return gateways
+	}
+
+	for _, endpoint := range endpoints {
+		port := uint(meshNetworkGatewayPort)
+		for _, p := range endpoint.Ports {
+			if p.Port == meshNetworkGatewayPort {
+				port = uint(p.ExposedPort)
+				break
+			}
+		}
+		gateways = append(gateways, &MeshNetworkGateway{
+			Address: endpoint.Address, Port: port,
+		})
+	}
+
+	return gateways
+}
+
+func GetMeshNetworksHash(config *devopsv1beta1.Istio, remoteIstios []devopsv1beta1.RemoteIstio) string {
 	hash := ""
-	j, err := json.Marshal(GetMeshNetworks(config))
+	j, err := json.Marshal(GetMeshNetworks(config, remoteIstios))
 	if err != nil {
 		return hash
 	}
@@ -127,7 +146,7 @@ func ObjectMetaClusterScope(name string, labels map[string]string, config runtim
 
 	return metav1.ObjectMeta{
 		Name:   name,
-		Labels: labels,
+		Labels: utils.MergeStringMaps(labels, utils.OwnerLabels(ovk.Kind, objMeta.GetName(), objMeta.GetNamespace())),
 		OwnerReferences: []metav1.OwnerReference{
 			{
 				APIVersion:         ovk.GroupVersion().String(),
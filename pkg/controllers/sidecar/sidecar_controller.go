@@ -18,35 +18,58 @@ package sidecar
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
-
-	"github.com/pkg/errors"
-	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/sidecar"
+	"github.com/symcn/mid-operator/pkg/metrics"
+	"github.com/symcn/mid-operator/pkg/utils"
 )
 
+const finalizerID = "sidecar.devops.symcn.com"
+
 // SidecarReconciler reconciles a Sidecar object
 type SidecarReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Mgr    manager.Manager
-	Scheme *runtime.Scheme
+	dynamic dynamic.Interface
+	Log     logr.Logger
+	Mgr     manager.Manager
+	Scheme  *runtime.Scheme
 }
 
 func Add(mgr manager.Manager) error {
+	dy, err := dynamic.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return emperror.Wrap(err, "failed to create dynamic client")
+	}
+
 	reconciler := &SidecarReconciler{
-		Client: mgr.GetClient(),
-		Mgr:    mgr,
-		Log:    ctrl.Log.WithName("controllers").WithName("Sidecar"),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		dynamic: dy,
+		Mgr:     mgr,
+		Log:     ctrl.Log.WithName("controllers").WithName("Sidecar"),
+		Scheme:  mgr.GetScheme(),
 	}
 
-	err := reconciler.SetupWithManager(mgr)
+	err = reconciler.SetupWithManager(mgr)
 	if err != nil {
 		return errors.Wrapf(err, "unable to create Sidecar controller")
 	}
@@ -55,19 +78,180 @@ func Add(mgr manager.Manager) error {
 }
 
 func (r *SidecarReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	c, err := ctrl.NewControllerManagedBy(mgr).
 		For(&devopsv1beta1.Sidecar{}).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(&source.Kind{Type: &appsv1.Deployment{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.sidecarsForWorkload),
+	}, deploymentPredicate)
+}
+
+// deploymentPredicate passes the changes of the deployments but the updates of their status only
+var deploymentPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() ||
+			!reflect.DeepEqual(e.MetaOld.GetAnnotations(), e.MetaNew.GetAnnotations())
+	},
 }
 
 // +kubebuilder:rbac:groups=devops.symcn.com,resources=sidecars,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=devops.symcn.com,resources=sidecars/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=networking.istio.io,resources=sidecars,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update;patch
 
 func (r *SidecarReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	_ = context.Background()
-	_ = r.Log.WithValues("sidecar", req.NamespacedName)
+	ctx := context.Background()
+	logger := r.Log.WithValues("key", req.NamespacedName)
 
-	// your logic here
+	config := &devopsv1beta1.Sidecar{}
+	err := r.Client.Get(ctx, req.NamespacedName, config)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			metrics.Forget("Sidecar", req.Namespace, req.Name)
+			return reconcile.Result{}, nil
+		}
+
+		logger.Error(err, "failed to get sidecar")
+		return reconcile.Result{}, err
+	}
+
+	reconciler := sidecar.New(r.Client, r.dynamic, config)
+
+	if !config.DeletionTimestamp.IsZero() {
+		if !utils.ContainsString(config.Finalizers, finalizerID) {
+			return reconcile.Result{}, nil
+		}
+
+		err = reconciler.Cleanup(logger)
+		if err != nil {
+			return reconcile.Result{}, emperror.Wrap(err, "could not clean up sidecar settings")
+		}
+
+		config.Finalizers = utils.RemoveString(config.Finalizers, finalizerID)
+		err = r.Client.Update(ctx, config)
+		if err != nil {
+			return reconcile.Result{}, emperror.Wrap(err, "could not remove finalizer")
+		}
+
+		return reconcile.Result{}, nil
+	}
+
+	if !utils.ContainsString(config.Finalizers, finalizerID) {
+		config.Finalizers = append(config.Finalizers, finalizerID)
+		err = r.Client.Update(ctx, config)
+		if err != nil {
+			return reconcile.Result{}, emperror.Wrap(err, "could not add finalizer")
+		}
+	}
+
+	err = reconciler.Reconcile(logger)
+	if err != nil {
+		updateErr := r.updateStatus(config, devopsv1beta1.ReconcileFailed, err.Error(), logger)
+		if updateErr != nil {
+			logger.Error(updateErr, "failed to update state")
+		}
+		return reconcile.Result{}, emperror.Wrap(err, "could not reconcile sidecar")
+	}
+
+	config.Status.Workloads, config.Status.ConflictingWorkloads, err = reconciler.Workloads()
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(config.Status.ConflictingWorkloads) > 0 {
+		logger.Info("workloads are configured by other sidecars", "workloads", config.Status.ConflictingWorkloads)
+	}
+
+	err = r.updateStatus(config, devopsv1beta1.Available, "", logger)
+	if err != nil {
+		return reconcile.Result{}, errors.WithStack(err)
+	}
+	logger.Info("reconcile finished")
 
 	return ctrl.Result{}, nil
 }
+
+// sidecarsForWorkload enqueues the Sidecars selecting the changed workload, the one configuring it
+// and the ones reporting it in their status
+func (r *SidecarReconciler) sidecarsForWorkload(o handler.MapObject) []reconcile.Request {
+	deployment, ok := o.Object.(*appsv1.Deployment)
+	if !ok {
+		return nil
+	}
+
+	var sidecars devopsv1beta1.SidecarList
+	err := r.Client.List(context.TODO(), &sidecars, client.InNamespace(deployment.Namespace))
+	if err != nil {
+		r.Log.Error(err, "could not list sidecar resources")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0)
+	for _, s := range sidecars.Items {
+		if !selectsWorkload(&s, deployment) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{
+			Name:      s.Name,
+			Namespace: s.Namespace,
+		}})
+	}
+
+	return requests
+}
+
+// selectsWorkload tells whether the Sidecar is concerned by a change of the deployment
+func selectsWorkload(s *devopsv1beta1.Sidecar, deployment *appsv1.Deployment) bool {
+	if deployment.Spec.Template.Annotations[sidecar.SidecarAnnotation] == s.Name {
+		return true
+	}
+	if labels.SelectorFromSet(s.Spec.WorkloadSelector).Matches(labels.Set(deployment.Spec.Template.Labels)) {
+		return true
+	}
+
+	// the workloads no longer selected have to be removed from the status
+	return utils.ContainsString(s.Status.Workloads, deployment.Name) ||
+		utils.ContainsString(s.Status.ConflictingWorkloads, deployment.Name)
+}
+
+func (r *SidecarReconciler) updateStatus(config *devopsv1beta1.Sidecar, status devopsv1beta1.ConfigState, errorMessage string, logger logr.Logger) error {
+	typeMeta := config.TypeMeta
+	config.Status.Status = status
+	config.Status.ErrorMessage = errorMessage
+	err := r.Client.Status().Update(context.Background(), config)
+	if apierrors.IsNotFound(err) {
+		err = r.Client.Update(context.Background(), config)
+	}
+	if err != nil {
+		if !apierrors.IsConflict(err) {
+			return emperror.Wrapf(err, "could not update Sidecar state to '%s'", status)
+		}
+		var actualConfig devopsv1beta1.Sidecar
+		err := r.Client.Get(context.TODO(), types.NamespacedName{
+			Namespace: config.Namespace,
+			Name:      config.Name,
+		}, &actualConfig)
+		if err != nil {
+			return emperror.Wrap(err, "could not get config for updating status")
+		}
+		actualConfig.Status.Status = status
+		actualConfig.Status.ErrorMessage = errorMessage
+		actualConfig.Status.Workloads = config.Status.Workloads
+		actualConfig.Status.ConflictingWorkloads = config.Status.ConflictingWorkloads
+		err = r.Client.Status().Update(context.Background(), &actualConfig)
+		if apierrors.IsNotFound(err) {
+			err = r.Client.Update(context.Background(), &actualConfig)
+		}
+		if err != nil {
+			return emperror.Wrapf(err, "could not update Sidecar state to '%s'", status)
+		}
+	}
+	// update loses the typeMeta of the config that's used later when setting ownerrefs
+	config.TypeMeta = typeMeta
+	metrics.SetConfigState("Sidecar", config.Namespace, config.Name, status)
+	logger.Info("Sidecar state updated", "status", status)
+	return nil
+}
@@ -2,13 +2,16 @@ package k8sutils
 
 import (
 	"context"
+	"errors"
 
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
 	"github.com/go-logr/logr"
 	"github.com/goph/emperror"
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 	"github.com/symcn/mid-operator/pkg/utils"
 	extensionsobj "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -24,8 +27,17 @@ func NewCRDReconciler(cli client.Client, crds ...*extensionsobj.CustomResourceDe
 	}
 }
 
+// DryRun returns a reconciler recording the changes of the CRDs instead of applying them
+func (r *CRDReconciler) DryRun(changes *ChangeRecorder) *CRDReconciler {
+	return &CRDReconciler{
+		crds:       r.crds,
+		runtimeCli: WithDryRun(r.runtimeCli, changes),
+	}
+}
+
 func (r *CRDReconciler) Reconcile(log logr.Logger) error {
 	log = log.WithValues("component", utils.ComponentNameCrd)
+	changes := dryRunChanges(r.runtimeCli)
 	for _, obj := range r.crds {
 		crd := obj.DeepCopy()
 		log := log.WithValues("kind", crd.Spec.Names.Kind)
@@ -35,6 +47,10 @@ func (r *CRDReconciler) Reconcile(log logr.Logger) error {
 			return emperror.WrapWith(err, "getting CRD failed", "kind", crd.Spec.Names.Kind)
 		}
 		if apierrors.IsNotFound(err) {
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeCreate, "CustomResourceDefinition", "", crd.Name, nil)
+				continue
+			}
 			if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(crd); err != nil {
 				log.Error(err, "Failed to set last applied annotation", "crd", crd)
 			}
@@ -57,25 +73,31 @@ func (r *CRDReconciler) Reconcile(log logr.Logger) error {
 					"modified", string(patchResult.Modified),
 					"original", string(patchResult.Original))
 			}
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeUpdate, "CustomResourceDefinition", "", crd.Name, patchOf(patchResult))
+				continue
+			}
 
 			if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(crd); err != nil {
 				log.Error(err, "Failed to set last applied annotation", "crd", crd)
 			}
 
-			if err := r.runtimeCli.Update(context.TODO(), crd); err != nil {
-				if apierrors.IsConflict(err) || apierrors.IsInvalid(err) {
-					err := r.runtimeCli.Delete(context.TODO(), crd)
-					if err != nil {
-						return emperror.WrapWith(err, "could not delete CRD", "kind", crd.Spec.Names.Kind)
-					}
-					crd.ResourceVersion = ""
-					if err := r.runtimeCli.Create(context.TODO(), crd); err != nil {
-						log.Info("resource needs to be re-created")
-						return emperror.WrapWith(err, "creating CRD failed", "kind", crd.Spec.Names.Kind)
+			// the CRDs are never deleted, that would delete every resource of their kind in the cluster
+			if crd.Spec.Scope != "" && crd.Spec.Scope != current.Spec.Scope {
+				return emperror.With(errors.New("the scope of a CRD cannot be changed"), "kind", crd.Spec.Names.Kind, "scope", current.Spec.Scope)
+			}
+			err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				err := r.runtimeCli.Update(context.TODO(), crd)
+				if apierrors.IsConflict(err) {
+					// the CRD changed since it was read, the update is retried on its latest version
+					if err := r.runtimeCli.Get(context.TODO(), client.ObjectKey{Name: crd.Name}, current); err != nil {
+						return err
 					}
-					log.Info("CRD created")
+					crd.ResourceVersion = current.ResourceVersion
 				}
-
+				return err
+			})
+			if err != nil {
 				return emperror.WrapWith(err, "updating CRD failed", "kind", crd.Spec.Names.Kind)
 			}
 			log.Info("CRD updated")
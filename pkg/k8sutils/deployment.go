@@ -0,0 +1,44 @@
+package k8sutils
+
+import (
+	"context"
+
+	"github.com/goph/emperror"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsDeploymentRolledOut returns whether every replica of the deployment runs its latest pod template and is available
+func IsDeploymentRolledOut(client client.Client, name types.NamespacedName) (bool, error) {
+	var deployment appsv1.Deployment
+	err := client.Get(context.Background(), name, &deployment)
+	if err != nil {
+		return false, emperror.WrapWith(err, "could not get deployment", "name", name.Name, "namespace", name.Namespace)
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.Replicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas, nil
+}
+
+// IsDeploymentAvailable returns whether a replica of the deployment is available, a missing deployment is not
+func IsDeploymentAvailable(client client.Client, name types.NamespacedName) (bool, error) {
+	var deployment appsv1.Deployment
+	err := client.Get(context.Background(), name, &deployment)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, emperror.WrapWith(err, "could not get deployment", "name", name.Name, "namespace", name.Namespace)
+	}
+
+	return deployment.Status.AvailableReplicas > 0, nil
+}
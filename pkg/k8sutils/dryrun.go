@@ -0,0 +1,205 @@
+package k8sutils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+)
+
+// ChangeRecorder collects the changes the dry-run clients were asked to make
+type ChangeRecorder struct {
+	sync.Mutex
+	changes []devopsv1beta1.ResourceChange
+}
+
+func NewChangeRecorder() *ChangeRecorder {
+	return &ChangeRecorder{}
+}
+
+// Changes returns the recorded changes in the order they were requested
+func (r *ChangeRecorder) Changes() []devopsv1beta1.ResourceChange {
+	r.Lock()
+	defer r.Unlock()
+
+	return append([]devopsv1beta1.ResourceChange(nil), r.changes...)
+}
+
+func (r *ChangeRecorder) record(operation devopsv1beta1.ResourceChangeOperation, kind, namespace, name string, patch []byte) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.changes = append(r.changes, devopsv1beta1.ResourceChange{
+		Operation: operation,
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+		Patch:     string(patch),
+	})
+}
+
+// dryRunChanges returns the recorder of the client if it is a dry-run one, nil otherwise
+func dryRunChanges(client interface{}) *ChangeRecorder {
+	switch c := client.(type) {
+	case *dryRunClient:
+		return c.changes
+	case *dryRunDynamicClient:
+		return c.changes
+	}
+
+	return nil
+}
+
+// patchOf returns the patch of the result, which is nil when the objects could not be matched
+func patchOf(result *patch.PatchResult) []byte {
+	if result == nil {
+		return nil
+	}
+
+	return result.Patch
+}
+
+// dryRunClient reads through the client but only records the writes
+type dryRunClient struct {
+	runtimeClient.Client
+	changes *ChangeRecorder
+}
+
+// WithDryRun returns a client recording the changes made through it instead of applying them,
+// Reconcile records the patch of the updates as well
+func WithDryRun(client runtimeClient.Client, changes *ChangeRecorder) runtimeClient.Client {
+	return &dryRunClient{
+		Client:  client,
+		changes: changes,
+	}
+}
+
+func (c *dryRunClient) Create(ctx context.Context, obj runtime.Object, opts ...runtimeClient.CreateOption) error {
+	c.recordObject(devopsv1beta1.ResourceChangeCreate, obj)
+	return nil
+}
+
+func (c *dryRunClient) Update(ctx context.Context, obj runtime.Object, opts ...runtimeClient.UpdateOption) error {
+	c.recordObject(devopsv1beta1.ResourceChangeUpdate, obj)
+	return nil
+}
+
+func (c *dryRunClient) Patch(ctx context.Context, obj runtime.Object, p runtimeClient.Patch, opts ...runtimeClient.PatchOption) error {
+	data, _ := p.Data(obj)
+	key, _ := runtimeClient.ObjectKeyFromObject(obj)
+	c.changes.record(devopsv1beta1.ResourceChangeUpdate, kindOf(obj), key.Namespace, key.Name, data)
+	return nil
+}
+
+func (c *dryRunClient) Delete(ctx context.Context, obj runtime.Object, opts ...runtimeClient.DeleteOption) error {
+	c.recordObject(devopsv1beta1.ResourceChangeDelete, obj)
+	return nil
+}
+
+func (c *dryRunClient) DeleteAllOf(ctx context.Context, obj runtime.Object, opts ...runtimeClient.DeleteAllOfOption) error {
+	c.changes.record(devopsv1beta1.ResourceChangeDelete, kindOf(obj), "", "*", nil)
+	return nil
+}
+
+func (c *dryRunClient) recordObject(operation devopsv1beta1.ResourceChangeOperation, obj runtime.Object) {
+	key, _ := runtimeClient.ObjectKeyFromObject(obj)
+	c.changes.record(operation, kindOf(obj), key.Namespace, key.Name, nil)
+}
+
+// dryRunDynamicClient reads through the dynamic client but only records the writes
+type dryRunDynamicClient struct {
+	dynamic.Interface
+	changes *ChangeRecorder
+}
+
+// WithDynamicDryRun returns a dynamic client recording the changes made through it instead of applying them,
+// DynamicObject.Reconcile records the patch of the updates as well
+func WithDynamicDryRun(client dynamic.Interface, changes *ChangeRecorder) dynamic.Interface {
+	if client == nil {
+		return nil
+	}
+
+	return &dryRunDynamicClient{
+		Interface: client,
+		changes:   changes,
+	}
+}
+
+func (c *dryRunDynamicClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	resource := c.Interface.Resource(gvr)
+	return &dryRunResource{
+		ResourceInterface: resource,
+		resource:          resource,
+		changes:           c.changes,
+	}
+}
+
+// dryRunResource serves both the cluster scoped and the namespaced resource interfaces
+type dryRunResource struct {
+	dynamic.ResourceInterface
+	resource  dynamic.NamespaceableResourceInterface
+	namespace string
+	changes   *ChangeRecorder
+}
+
+func (r *dryRunResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &dryRunResource{
+		ResourceInterface: r.resource.Namespace(namespace),
+		resource:          r.resource,
+		namespace:         namespace,
+		changes:           r.changes,
+	}
+}
+
+func (r *dryRunResource) Create(obj *unstructured.Unstructured, options metav1.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	r.changes.record(devopsv1beta1.ResourceChangeCreate, obj.GetKind(), r.namespace, obj.GetName(), nil)
+	return obj, nil
+}
+
+func (r *dryRunResource) Update(obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	r.changes.record(devopsv1beta1.ResourceChangeUpdate, obj.GetKind(), r.namespace, obj.GetName(), nil)
+	return obj, nil
+}
+
+func (r *dryRunResource) UpdateStatus(obj *unstructured.Unstructured, options metav1.UpdateOptions) (*unstructured.Unstructured, error) {
+	return obj, nil
+}
+
+// Delete and Patch only get the name of the object, its kind is read from the current object
+func (r *dryRunResource) Delete(name string, options *metav1.DeleteOptions, subresources ...string) error {
+	current, err := r.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	r.changes.record(devopsv1beta1.ResourceChangeDelete, current.GetKind(), r.namespace, name, nil)
+	return nil
+}
+
+func (r *dryRunResource) DeleteCollection(options *metav1.DeleteOptions, listOptions metav1.ListOptions) error {
+	current, err := r.List(listOptions)
+	if err != nil {
+		return err
+	}
+	for _, item := range current.Items {
+		r.changes.record(devopsv1beta1.ResourceChangeDelete, item.GetKind(), r.namespace, item.GetName(), nil)
+	}
+	return nil
+}
+
+func (r *dryRunResource) Patch(name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	current, err := r.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	r.changes.record(devopsv1beta1.ResourceChangeUpdate, current.GetKind(), r.namespace, name, data)
+	return current, nil
+}
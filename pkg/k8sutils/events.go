@@ -0,0 +1,80 @@
+package k8sutils
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/record"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Reasons of the events recorded on the owner of the reconciled resources
+const (
+	EventReasonCreated   = "Created"
+	EventReasonUpdated   = "Updated"
+	EventReasonRecreated = "Recreated"
+	EventReasonDeleted   = "Deleted"
+)
+
+// eventTarget is the owner the changes made through a client are reported on
+type eventTarget struct {
+	recorder record.EventRecorder
+	owner    runtime.Object
+}
+
+type eventClient struct {
+	runtimeClient.Client
+	eventTarget
+}
+
+type eventDynamicClient struct {
+	dynamic.Interface
+	eventTarget
+}
+
+// WithEvents returns a client whose resources reconciled by Reconcile are reported as events on the owner
+func WithEvents(client runtimeClient.Client, recorder record.EventRecorder, owner runtime.Object) runtimeClient.Client {
+	if client == nil || recorder == nil {
+		return client
+	}
+
+	return &eventClient{
+		Client:      client,
+		eventTarget: eventTarget{recorder: recorder, owner: owner},
+	}
+}
+
+// WithDynamicEvents returns a dynamic client whose resources reconciled by DynamicObject.Reconcile
+// are reported as events on the owner
+func WithDynamicEvents(client dynamic.Interface, recorder record.EventRecorder, owner runtime.Object) dynamic.Interface {
+	if client == nil || recorder == nil {
+		return client
+	}
+
+	return &eventDynamicClient{
+		Interface:   client,
+		eventTarget: eventTarget{recorder: recorder, owner: owner},
+	}
+}
+
+// RecordResourceEvent reports the change of a resource on the owner of the client, if the client was created
+// by WithEvents or WithDynamicEvents
+func RecordResourceEvent(client interface{}, reason, kind, namespace, name string) {
+	var target eventTarget
+	switch c := client.(type) {
+	case *eventClient:
+		target = c.eventTarget
+	case *eventDynamicClient:
+		target = c.eventTarget
+	default:
+		return
+	}
+
+	if namespace != "" {
+		name = namespace + "/" + name
+	}
+	target.recorder.Event(target.owner, corev1.EventTypeNormal, reason, fmt.Sprintf("%s %s %s", kind, name, strings.ToLower(reason)))
+}
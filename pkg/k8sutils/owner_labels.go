@@ -0,0 +1,31 @@
+package k8sutils
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/symcn/mid-operator/pkg/utils"
+)
+
+// EnqueueRequestForOwnerLabels enqueues the owner of a cluster scoped object referenced by its owner labels,
+// if the owner is of the given kind
+func EnqueueRequestForOwnerLabels(kind string) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(o handler.MapObject) []reconcile.Request {
+			labels := o.Meta.GetLabels()
+			if labels[utils.OwnerKindLabel] != kind || labels[utils.OwnerNameLabel] == "" {
+				return nil
+			}
+
+			return []reconcile.Request{
+				{
+					NamespacedName: client.ObjectKey{
+						Name:      labels[utils.OwnerNameLabel],
+						Namespace: labels[utils.OwnerNamespaceLabel],
+					},
+				},
+			}
+		}),
+	}
+}
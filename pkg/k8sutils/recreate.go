@@ -0,0 +1,67 @@
+package k8sutils
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// immutableFieldsChanged reports whether the desired object changes a field of the current one
+// the API server refuses to update
+type immutableFieldsChanged func(current, desired runtime.Object) bool
+
+// immutableFields are the known immutable fields of the kinds Reconcile is able to re-create
+var immutableFields = map[string]immutableFieldsChanged{
+	"Deployment": func(current, desired runtime.Object) bool {
+		return !reflect.DeepEqual(current.(*appsv1.Deployment).Spec.Selector, desired.(*appsv1.Deployment).Spec.Selector)
+	},
+	"DaemonSet": func(current, desired runtime.Object) bool {
+		return !reflect.DeepEqual(current.(*appsv1.DaemonSet).Spec.Selector, desired.(*appsv1.DaemonSet).Spec.Selector)
+	},
+	"Service": func(current, desired runtime.Object) bool {
+		clusterIP := desired.(*corev1.Service).Spec.ClusterIP
+		return clusterIP != "" && clusterIP != current.(*corev1.Service).Spec.ClusterIP
+	},
+}
+
+// recreatableKinds are the kinds whose objects are re-created on an immutable field change,
+// the others are left as they are and the update fails
+var recreatableKinds = map[string]bool{}
+
+// RecreatableKinds returns the kinds SetRecreatableKinds accepts
+func RecreatableKinds() []string {
+	kinds := make([]string, 0, len(immutableFields))
+	for kind := range immutableFields {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// SetRecreatableKinds opts the kinds in to be deleted and re-created by Reconcile when one of their known
+// immutable fields changes, re-creating a LoadBalancer Service loses its address and re-creating a Deployment
+// stops its pods. It is meant to be called once, before the controllers start.
+func SetRecreatableKinds(kinds ...string) error {
+	enabled := make(map[string]bool, len(kinds))
+	for _, kind := range kinds {
+		if _, ok := immutableFields[kind]; !ok {
+			return fmt.Errorf("kind %s cannot be re-created, supported kinds: %s", kind, strings.Join(RecreatableKinds(), ", "))
+		}
+		enabled[kind] = true
+	}
+	recreatableKinds = enabled
+
+	return nil
+}
+
+// immutableFieldChanged returns whether the object has to be re-created for the desired state to be applied
+func immutableFieldChanged(current, desired runtime.Object) bool {
+	changed, ok := immutableFields[kindOf(desired)]
+	return ok && changed(current, desired)
+}
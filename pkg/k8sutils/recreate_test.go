@@ -0,0 +1,82 @@
+package k8sutils
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func deploymentSelecting(labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+		},
+	}
+}
+
+func serviceWithClusterIP(clusterIP string) *corev1.Service {
+	return &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			ClusterIP: clusterIP,
+		},
+	}
+}
+
+func TestImmutableFieldChanged(t *testing.T) {
+	tests := []struct {
+		name    string
+		current runtime.Object
+		desired runtime.Object
+		want    bool
+	}{
+		{
+			name:    "deployment with the same selector",
+			current: deploymentSelecting(map[string]string{"app": "istiod"}),
+			desired: deploymentSelecting(map[string]string{"app": "istiod"}),
+		},
+		{
+			name:    "deployment with another selector",
+			current: deploymentSelecting(map[string]string{"app": "istiod"}),
+			desired: deploymentSelecting(map[string]string{"app": "istiod", "istio.io/rev": "canary"}),
+			want:    true,
+		},
+		{
+			name:    "daemonset with another selector",
+			current: &appsv1.DaemonSet{Spec: appsv1.DaemonSetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "istio-cni-node"}}}},
+			desired: &appsv1.DaemonSet{Spec: appsv1.DaemonSetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "istio-cni-node"}}}},
+			want:    true,
+		},
+		{
+			name:    "service without a requested cluster ip",
+			current: serviceWithClusterIP("10.0.0.1"),
+			desired: serviceWithClusterIP(""),
+		},
+		{
+			name:    "service with the allocated cluster ip",
+			current: serviceWithClusterIP("10.0.0.1"),
+			desired: serviceWithClusterIP("10.0.0.1"),
+		},
+		{
+			name:    "service with another cluster ip",
+			current: serviceWithClusterIP("10.0.0.1"),
+			desired: serviceWithClusterIP("None"),
+			want:    true,
+		},
+		{
+			name:    "kind without immutable fields",
+			current: &corev1.ConfigMap{Data: map[string]string{"a": "b"}},
+			desired: &corev1.ConfigMap{Data: map[string]string{"a": "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := immutableFieldChanged(tt.current, tt.desired); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -11,9 +11,15 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/k8sclient"
+	"github.com/symcn/mid-operator/pkg/metrics"
 )
 
 func Reconcile(log logr.Logger, client runtimeClient.Client, desired runtime.Object, desiredState DesiredState) error {
@@ -34,14 +40,21 @@ func Reconcile(log logr.Logger, client runtimeClient.Client, desired runtime.Obj
 	if err != nil && !apierrors.IsNotFound(err) {
 		return emperror.WrapWith(err, "getting resource failed", "kind", desiredType, "name", key.Name)
 	}
+	changes := dryRunChanges(client)
 	if apierrors.IsNotFound(err) {
 		if desiredState == DesiredStatePresent {
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeCreate, kindOf(desired), key.Namespace, key.Name, nil)
+				return nil
+			}
 			if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(desired); err != nil {
 				log.Error(err, "Failed to set last applied annotation", "desired", desired)
 			}
 			if err := client.Create(context.TODO(), desired); err != nil {
 				return emperror.WrapWith(err, "creating resource failed", "kind", desiredType, "name", key.Name)
 			}
+			metrics.RecordResourceOperation(kindOf(desired), metrics.OperationCreated)
+			RecordResourceEvent(client, EventReasonCreated, kindOf(desired), key.Namespace, key.Name)
 			log.Info("resource created")
 		}
 	} else {
@@ -59,6 +72,10 @@ func Reconcile(log logr.Logger, client runtimeClient.Client, desired runtime.Obj
 					"modified", string(patchResult.Modified),
 					"original", string(patchResult.Original))
 			}
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeUpdate, kindOf(desired), key.Namespace, key.Name, patchOf(patchResult))
+				return nil
+			}
 
 			// Need to set this before resourceversion is set, as it would constantly change otherwise
 			if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(desired); err != nil {
@@ -66,47 +83,97 @@ func Reconcile(log logr.Logger, client runtimeClient.Client, desired runtime.Obj
 			}
 
 			metaAccessor := meta.NewAccessor()
-			currentResourceVersion, err := metaAccessor.ResourceVersion(current)
-			if err != nil {
+			err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				currentResourceVersion, err := metaAccessor.ResourceVersion(current)
+				if err != nil {
+					return err
+				}
+				metaAccessor.SetResourceVersion(desired, currentResourceVersion)
+				prepareResourceForUpdate(current, desired)
+
+				err = client.Update(context.TODO(), desired)
+				if apierrors.IsConflict(err) {
+					// the resource changed since it was read, the update is retried on its latest version
+					log.V(1).Info("resource changed, retrying the update")
+					if err := client.Get(context.TODO(), key, current); err != nil {
+						return err
+					}
+				}
 				return err
-			}
-
-			metaAccessor.SetResourceVersion(desired, currentResourceVersion)
-			prepareResourceForUpdate(current, desired)
-
-			if err := client.Update(context.TODO(), desired); err != nil {
-				if apierrors.IsConflict(err) || apierrors.IsInvalid(err) {
+			})
+			if err != nil {
+				if apierrors.IsInvalid(err) && immutableFieldChanged(current, desiredCopy) {
+					if !recreatableKinds[kindOf(desired)] {
+						return emperror.WrapWith(err, "immutable field changed, re-creating the kind is not enabled", "kind", desiredType, "name", key.Name)
+					}
 					log.Info("resource needs to be re-created", "error", err)
 					err := client.Delete(context.TODO(), current)
 					if err != nil {
 						return emperror.WrapWith(err, "could not delete resource", "kind", desiredType, "name", key.Name)
 					}
 					log.Info("resource deleted")
+					if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(desiredCopy); err != nil {
+						log.Error(err, "Failed to set last applied annotation", "desired", desiredCopy)
+					}
 					if err := client.Create(context.TODO(), desiredCopy); err != nil {
 						return emperror.WrapWith(err, "creating resource failed", "kind", desiredType, "name", key.Name)
 					}
+					metrics.RecordResourceOperation(kindOf(desired), metrics.OperationRecreated)
+					RecordResourceEvent(client, EventReasonRecreated, kindOf(desired), key.Namespace, key.Name)
 					log.Info("resource created")
 					return nil
 				}
 
 				return emperror.WrapWith(err, "updating resource failed", "kind", desiredType, "name", key.Name)
 			}
+			metrics.RecordResourceOperation(kindOf(desired), metrics.OperationUpdated)
+			RecordResourceEvent(client, EventReasonUpdated, kindOf(desired), key.Namespace, key.Name)
 			log.Info("resource updated")
 		} else if desiredState == DesiredStateAbsent {
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeDelete, kindOf(desired), key.Namespace, key.Name, nil)
+				return nil
+			}
 			if err := client.Delete(context.TODO(), current); err != nil {
 				return emperror.WrapWith(err, "deleting resource failed", "kind", desiredType, "name", key.Name)
 			}
+			metrics.RecordResourceOperation(kindOf(desired), metrics.OperationDeleted)
+			RecordResourceEvent(client, EventReasonDeleted, kindOf(desired), key.Namespace, key.Name)
 			log.Info("resource deleted")
 		}
 	}
 	return nil
 }
 
+// kindOf returns the kind of the typed object, whose type meta is usually left empty
+func kindOf(o runtime.Object) string {
+	gvk, err := apiutil.GVKForObject(o, k8sclient.GetScheme())
+	if err != nil {
+		return reflect.TypeOf(o).String()
+	}
+
+	return gvk.Kind
+}
+
 func prepareResourceForUpdate(current, desired runtime.Object) {
 	switch desired.(type) {
 	case *corev1.Service:
+		// the allocated cluster IP is kept, a different one requested on purpose can only be applied by a re-creation
 		svc := desired.(*corev1.Service)
-		svc.Spec.ClusterIP = current.(*corev1.Service).Spec.ClusterIP
+		if svc.Spec.ClusterIP == "" {
+			svc.Spec.ClusterIP = current.(*corev1.Service).Spec.ClusterIP
+		}
+	case *devopsv1beta1.MeshGateway:
+		// the finalizer and the unmanaged annotation are set on the mesh gateway apart from its owner
+		mgw := desired.(*devopsv1beta1.MeshGateway)
+		currentMgw := current.(*devopsv1beta1.MeshGateway)
+		mgw.Finalizers = currentMgw.Finalizers
+		if devopsv1beta1.IsUnmanaged(currentMgw) {
+			if mgw.Annotations == nil {
+				mgw.Annotations = make(map[string]string)
+			}
+			mgw.Annotations[devopsv1beta1.UnmanagedAnnotation] = currentMgw.Annotations[devopsv1beta1.UnmanagedAnnotation]
+		}
 	}
 }
 
@@ -173,6 +240,7 @@ func ReconcileNamespaceLabelsIgnoreNotFound(log logr.Logger, client runtimeClien
 		if err := client.Update(context.TODO(), ns); err != nil {
 			return emperror.WrapWith(err, "updating namespace failed", "namespace", namespace)
 		}
+		RecordResourceEvent(client, EventReasonUpdated, "Namespace", "", namespace)
 		log.Info("namespace labels reconciled", "namespace", namespace, "labels", labels)
 	}
 
@@ -1,11 +1,11 @@
 package k8sutils
 
 import (
-	"bytes"
-	"net"
 	"sort"
 
 	corev1 "k8s.io/api/core/v1"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 )
 
 type IngressSetupPendingError struct{}
@@ -14,40 +14,118 @@ func (e IngressSetupPendingError) Error() string {
 	return "ingress gateway endpoint address is pending"
 }
 
-func GetServiceEndpointIPs(service corev1.Service) ([]string, error) {
-	ips := make([]string, 0)
+// GetServiceEndpoints returns the endpoints the service is reachable at from outside of its cluster: its external IPs
+// and, depending on its type, its cluster IP, every load balancer ingress or the addresses of the nodes along with
+// the node ports. The nodes are only needed for NodePort services. Hostnames are kept as they are, resolving them
+// is left to the consumers of the endpoints.
+func GetServiceEndpoints(service corev1.Service, nodes []corev1.Node) ([]devopsv1beta1.GatewayEndpoint, error) {
+	addresses := append([]string(nil), service.Spec.ExternalIPs...)
+	var nodeAddresses []string
 
 	switch service.Spec.Type {
 	case corev1.ServiceTypeClusterIP:
-		if service.Spec.ClusterIP != corev1.ClusterIPNone {
-			ips = []string{
-				service.Spec.ClusterIP,
-			}
+		if service.Spec.ClusterIP != corev1.ClusterIPNone && service.Spec.ClusterIP != "" {
+			addresses = append(addresses, service.Spec.ClusterIP)
 		}
 	case corev1.ServiceTypeLoadBalancer:
-		if len(service.Status.LoadBalancer.Ingress) < 1 {
-			return ips, IngressSetupPendingError{}
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				addresses = append(addresses, ingress.IP)
+			} else if ingress.Hostname != "" {
+				addresses = append(addresses, ingress.Hostname)
+			}
+		}
+		if len(service.Status.LoadBalancer.Ingress) == 0 {
+			return nil, IngressSetupPendingError{}
 		}
+	case corev1.ServiceTypeNodePort:
+		nodeAddresses = NodeAddresses(nodes)
+		if len(nodeAddresses) == 0 {
+			return nil, IngressSetupPendingError{}
+		}
+	}
 
-		if service.Status.LoadBalancer.Ingress[0].IP != "" {
-			ips = []string{
-				service.Status.LoadBalancer.Ingress[0].IP,
-			}
-		} else if service.Status.LoadBalancer.Ingress[0].Hostname != "" {
-			hostIPs, err := net.LookupIP(service.Status.LoadBalancer.Ingress[0].Hostname)
-			if err != nil {
-				return ips, err
-			}
-			sort.Slice(hostIPs, func(i, j int) bool {
-				return bytes.Compare(hostIPs[i], hostIPs[j]) < 0
-			})
-			for _, ip := range hostIPs {
-				if ip.To4() != nil {
-					ips = append(ips, ip.String())
-				}
+	endpoints := make([]devopsv1beta1.GatewayEndpoint, 0, len(addresses)+len(nodeAddresses))
+	for _, address := range unique(addresses) {
+		endpoints = append(endpoints, devopsv1beta1.GatewayEndpoint{
+			Address: address,
+			Ports:   endpointPorts(service.Spec.Ports, false),
+		})
+	}
+	for _, address := range nodeAddresses {
+		endpoints = append(endpoints, devopsv1beta1.GatewayEndpoint{
+			Address: address,
+			Ports:   endpointPorts(service.Spec.Ports, true),
+		})
+	}
+
+	return endpoints, nil
+}
+
+// NodeAddresses returns the external addresses of the nodes, both IPv4 and IPv6, or their internal ones
+// if none has an external address
+func NodeAddresses(nodes []corev1.Node) []string {
+	var external, internal []string
+	for _, node := range nodes {
+		for _, address := range node.Status.Addresses {
+			switch address.Type {
+			case corev1.NodeExternalIP:
+				external = append(external, address.Address)
+			case corev1.NodeInternalIP:
+				internal = append(internal, address.Address)
 			}
 		}
 	}
 
-	return ips, nil
+	if len(external) > 0 {
+		return unique(external)
+	}
+
+	return unique(internal)
+}
+
+// EndpointAddresses returns the addresses of the endpoints
+func EndpointAddresses(endpoints []devopsv1beta1.GatewayEndpoint) []string {
+	addresses := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		addresses = append(addresses, endpoint.Address)
+	}
+
+	return addresses
+}
+
+func endpointPorts(servicePorts []corev1.ServicePort, nodePorts bool) []devopsv1beta1.GatewayEndpointPort {
+	ports := make([]devopsv1beta1.GatewayEndpointPort, 0, len(servicePorts))
+	for _, port := range servicePorts {
+		exposed := port.Port
+		if nodePorts {
+			exposed = port.NodePort
+		}
+		// the node port is not allocated yet
+		if exposed == 0 {
+			continue
+		}
+		ports = append(ports, devopsv1beta1.GatewayEndpointPort{
+			Name:        port.Name,
+			Port:        port.Port,
+			ExposedPort: exposed,
+		})
+	}
+
+	return ports
+}
+
+// unique returns the sorted addresses without duplicates
+func unique(addresses []string) []string {
+	sorted := append([]string(nil), addresses...)
+	sort.Strings(sorted)
+
+	result := make([]string, 0, len(sorted))
+	for i, address := range sorted {
+		if i == 0 || address != sorted[i-1] {
+			result = append(result, address)
+		}
+	}
+
+	return result
 }
@@ -0,0 +1,113 @@
+package k8sutils
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+)
+
+func TestGetServiceEndpoints(t *testing.T) {
+	ports := []corev1.ServicePort{
+		{Name: "tls", Port: 15443, NodePort: 31443},
+		{Name: "http2", Port: 80},
+	}
+	servicePorts := []devopsv1beta1.GatewayEndpointPort{
+		{Name: "tls", Port: 15443, ExposedPort: 15443},
+		{Name: "http2", Port: 80, ExposedPort: 80},
+	}
+	nodePorts := []devopsv1beta1.GatewayEndpointPort{
+		{Name: "tls", Port: 15443, ExposedPort: 31443},
+	}
+	nodes := []corev1.Node{
+		{Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+			{Type: corev1.NodeExternalIP, Address: "1.1.1.2"},
+		}}},
+		{Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+			{Type: corev1.NodeExternalIP, Address: "1.1.1.1"},
+			{Type: corev1.NodeExternalIP, Address: "2001:db8::1"},
+		}}},
+	}
+	internalNodes := []corev1.Node{
+		{Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+			{Type: corev1.NodeHostName, Address: "node-1"},
+		}}},
+	}
+
+	tests := []struct {
+		name    string
+		spec    corev1.ServiceSpec
+		status  corev1.ServiceStatus
+		nodes   []corev1.Node
+		want    []devopsv1beta1.GatewayEndpoint
+		wantErr error
+	}{
+		{
+			name: "cluster ip",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: "172.16.0.1", Ports: ports},
+			want: []devopsv1beta1.GatewayEndpoint{{Address: "172.16.0.1", Ports: servicePorts}},
+		},
+		{
+			name: "headless service with external ips",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP, ClusterIP: corev1.ClusterIPNone, ExternalIPs: []string{"1.1.1.1"}, Ports: ports},
+			want: []devopsv1beta1.GatewayEndpoint{{Address: "1.1.1.1", Ports: servicePorts}},
+		},
+		{
+			name: "load balancer",
+			spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, ClusterIP: "172.16.0.1", Ports: ports},
+			status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+				{IP: "3.3.3.3"},
+				{Hostname: "gateway.example.com"},
+				{IP: "3.3.3.3"},
+			}}},
+			want: []devopsv1beta1.GatewayEndpoint{
+				{Address: "3.3.3.3", Ports: servicePorts},
+				{Address: "gateway.example.com", Ports: servicePorts},
+			},
+		},
+		{
+			name:    "load balancer pending",
+			spec:    corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer, ClusterIP: "172.16.0.1", Ports: ports},
+			wantErr: IngressSetupPendingError{},
+		},
+		{
+			name:  "node port on the external node addresses",
+			spec:  corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort, ClusterIP: "172.16.0.1", Ports: ports},
+			nodes: nodes,
+			want: []devopsv1beta1.GatewayEndpoint{
+				{Address: "1.1.1.1", Ports: nodePorts},
+				{Address: "1.1.1.2", Ports: nodePorts},
+				{Address: "2001:db8::1", Ports: nodePorts},
+			},
+		},
+		{
+			name:  "node port on the internal node addresses",
+			spec:  corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort, ClusterIP: "172.16.0.1", Ports: ports},
+			nodes: internalNodes,
+			want:  []devopsv1beta1.GatewayEndpoint{{Address: "10.0.0.1", Ports: nodePorts}},
+		},
+		{
+			name:    "node port without nodes",
+			spec:    corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort, ClusterIP: "172.16.0.1", Ports: ports},
+			wantErr: IngressSetupPendingError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := corev1.Service{Spec: tt.spec, Status: tt.status}
+			got, err := GetServiceEndpoints(service, tt.nodes)
+			if err != tt.wantErr {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,9 +10,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/client-go/dynamic"
 
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
 	"github.com/symcn/mid-operator/pkg/k8sclient"
+	"github.com/symcn/mid-operator/pkg/metrics"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -32,7 +35,8 @@ type DynamicObject struct {
 	Spec      map[string]interface{}
 	Gvr       schema.GroupVersionResource
 	Kind      string
-	Owner     metav1.Object
+	// Owner is optional, objects outside the namespace of their owner have none
+	Owner metav1.Object
 }
 
 func (d *DynamicObject) Reconcile(log logr.Logger, client dynamic.Interface, desiredState DesiredState) error {
@@ -46,14 +50,21 @@ func (d *DynamicObject) Reconcile(log logr.Logger, client dynamic.Interface, des
 	if err != nil && !apierrors.IsNotFound(err) {
 		return emperror.WrapWith(err, "getting resource failed", "name", d.Name, "kind", desiredType)
 	}
+	changes := dryRunChanges(client)
 	if apierrors.IsNotFound(err) {
 		if desiredState == DesiredStatePresent {
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeCreate, d.Kind, d.Namespace, d.Name, nil)
+				return nil
+			}
 			if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(desired); err != nil {
 				log.Error(err, "Failed to set last applied annotation", "desired", desired)
 			}
 			if _, err := client.Resource(d.Gvr).Namespace(d.Namespace).Create(desired, metav1.CreateOptions{}); err != nil {
 				return emperror.WrapWith(err, "creating resource failed", "name", d.Name, "kind", desiredType)
 			}
+			metrics.RecordResourceOperation(d.Kind, metrics.OperationCreated)
+			RecordResourceEvent(client, EventReasonCreated, d.Kind, d.Namespace, d.Name)
 			log.Info("resource created", "kind", d.Gvr.Resource)
 		}
 	} else {
@@ -71,6 +82,10 @@ func (d *DynamicObject) Reconcile(log logr.Logger, client dynamic.Interface, des
 					"modified", string(patchResult.Modified),
 					"original", string(patchResult.Original))
 			}
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeUpdate, d.Kind, d.Namespace, d.Name, patchOf(patchResult))
+				return nil
+			}
 			// Need to set this before resourceversion is set, as it would constantly change otherwise
 			if err := patch.DefaultAnnotator.SetLastAppliedAnnotation(desired); err != nil {
 				log.Error(err, "Failed to set last applied annotation", "desired", desired)
@@ -79,11 +94,19 @@ func (d *DynamicObject) Reconcile(log logr.Logger, client dynamic.Interface, des
 			if _, err := client.Resource(d.Gvr).Namespace(d.Namespace).Update(desired, metav1.UpdateOptions{}); err != nil {
 				return emperror.WrapWith(err, "updating resource failed", "name", d.Name, "kind", desiredType)
 			}
+			metrics.RecordResourceOperation(d.Kind, metrics.OperationUpdated)
+			RecordResourceEvent(client, EventReasonUpdated, d.Kind, d.Namespace, d.Name)
 			log.Info("resource updated", "kind", d.Gvr.Resource)
 		} else if desiredState == DesiredStateAbsent {
+			if changes != nil {
+				changes.record(devopsv1beta1.ResourceChangeDelete, d.Kind, d.Namespace, d.Name, nil)
+				return nil
+			}
 			if err := client.Resource(d.Gvr).Namespace(d.Namespace).Delete(d.Name, &metav1.DeleteOptions{}); err != nil {
 				return emperror.WrapWith(err, "deleting resource failed", "name", d.Name, "kind", desiredType)
 			}
+			metrics.RecordResourceOperation(d.Kind, metrics.OperationDeleted)
+			RecordResourceEvent(client, EventReasonDeleted, d.Kind, d.Namespace, d.Name)
 			log.Info("resource deleted", "kind", d.Gvr.Resource)
 		}
 	}
@@ -93,7 +116,7 @@ func (d *DynamicObject) Reconcile(log logr.Logger, client dynamic.Interface, des
 func (d *DynamicObject) unstructured() *unstructured.Unstructured {
 	u := &unstructured.Unstructured{
 		Object: map[string]interface{}{
-			"spec": d.Spec,
+			"spec": jsonValue(d.Spec),
 		},
 	}
 	u.SetName(d.Name)
@@ -109,6 +132,10 @@ func (d *DynamicObject) unstructured() *unstructured.Unstructured {
 		Kind:    d.Kind,
 	})
 
+	if d.Owner == nil {
+		return u
+	}
+
 	ro, ok := d.Owner.(runtime.Object)
 	if !ok {
 		klog.Errorf("is not a %T a runtime.Object, cannot call SetControllerReference", d.Owner)
@@ -126,3 +153,20 @@ func (d *DynamicObject) unstructured() *unstructured.Unstructured {
 	u.SetOwnerReferences([]metav1.OwnerReference{ref})
 	return u
 }
+
+// jsonValue converts the spec to plain JSON values, typed slices and maps of the spec cannot be deep copied otherwise
+func jsonValue(spec map[string]interface{}) map[string]interface{} {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		klog.Errorf("cannot marshal spec, err: %+v", err)
+		return spec
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		klog.Errorf("cannot unmarshal spec, err: %+v", err)
+		return spec
+	}
+
+	return value
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The symcn authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+)
+
+const namespace = "mid_operator"
+
+// Operation is a change made to a resource by the operator
+type Operation string
+
+const (
+	OperationCreated   Operation = "created"
+	OperationUpdated   Operation = "updated"
+	OperationRecreated Operation = "recreated"
+	OperationDeleted   Operation = "deleted"
+)
+
+var (
+	resourceOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "resource_operations_total",
+		Help:      "Number of resources created, updated, recreated and deleted by the operator per kind",
+	}, []string{"kind", "operation"})
+
+	componentReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "component_reconcile_duration_seconds",
+		Help:      "Duration of the reconciliation of the components, per component and result",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"component", "result"})
+
+	configState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "config_state",
+		Help:      "Current state of the resources reconciled by the operator, the series of the current state is set to 1",
+	}, []string{"kind", "namespace", "name", "state"})
+
+	gatewayAddressPending = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "gateway_address_pending_since_timestamp_seconds",
+		Help:      "Unix time the address of the gateway of the resource became pending, 0 once assigned",
+	}, []string{"kind", "namespace", "name"})
+)
+
+// tracker remembers the current state and whether the gateway address is pending for each resource,
+// the series of the previous state is removed on a change and every series on deletion
+type tracker struct {
+	sync.Mutex
+	states  map[resourceKey]devopsv1beta1.ConfigState
+	pending map[resourceKey]bool
+}
+
+type resourceKey struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+var resources = &tracker{
+	states:  make(map[resourceKey]devopsv1beta1.ConfigState),
+	pending: make(map[resourceKey]bool),
+}
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		resourceOperations,
+		componentReconcileDuration,
+		configState,
+		gatewayAddressPending,
+	)
+}
+
+// RecordResourceOperation counts a change made to a resource of the given kind
+func RecordResourceOperation(kind string, operation Operation) {
+	resourceOperations.WithLabelValues(kind, string(operation)).Inc()
+}
+
+// ObserveComponentReconcile records the duration of the reconciliation of a component
+func ObserveComponentReconcile(component string, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	componentReconcileDuration.WithLabelValues(component, result).Observe(duration.Seconds())
+}
+
+// SetConfigState reports the current state of the resource
+func SetConfigState(kind, namespace, name string, state devopsv1beta1.ConfigState) {
+	key := resourceKey{kind: kind, namespace: namespace, name: name}
+
+	resources.Lock()
+	defer resources.Unlock()
+
+	if previous, ok := resources.states[key]; ok && previous != state {
+		configState.DeleteLabelValues(kind, namespace, name, string(previous))
+	}
+	resources.states[key] = state
+	configState.WithLabelValues(kind, namespace, name, string(state)).Set(1)
+}
+
+// GatewayAddressPending reports the time the address of the gateway of the resource became pending,
+// the timestamp is only set on the first call until the address is assigned
+func GatewayAddressPending(kind, namespace, name string) {
+	key := resourceKey{kind: kind, namespace: namespace, name: name}
+
+	resources.Lock()
+	defer resources.Unlock()
+
+	if resources.pending[key] {
+		return
+	}
+	resources.pending[key] = true
+	gatewayAddressPending.WithLabelValues(kind, namespace, name).SetToCurrentTime()
+}
+
+// GatewayAddressAssigned resets the pending timestamp of the gateway address of the resource
+func GatewayAddressAssigned(kind, namespace, name string) {
+	key := resourceKey{kind: kind, namespace: namespace, name: name}
+
+	resources.Lock()
+	defer resources.Unlock()
+
+	delete(resources.pending, key)
+	gatewayAddressPending.WithLabelValues(kind, namespace, name).Set(0)
+}
+
+// Forget removes the series of the deleted resource
+func Forget(kind, namespace, name string) {
+	key := resourceKey{kind: kind, namespace: namespace, name: name}
+
+	resources.Lock()
+	defer resources.Unlock()
+
+	if state, ok := resources.states[key]; ok {
+		configState.DeleteLabelValues(kind, namespace, name, string(state))
+		delete(resources.states, key)
+	}
+	delete(resources.pending, key)
+	gatewayAddressPending.DeleteLabelValues(kind, namespace, name)
+}
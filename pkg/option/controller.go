@@ -16,14 +16,33 @@ limitations under the License.
 
 package option
 
+import "time"
+
 type ControllersManagerOption struct {
-	EnableSidecar bool
-	EnableIstio   bool
+	EnableSidecar  bool
+	EnableIstio    bool
+	EnableWebhook  bool
+	WebhookPort    int
+	WebhookCertDir string
+	MetricsAddr    string
+	// RecreateKinds are the kinds re-created when one of their immutable fields changes
+	RecreateKinds []string
+	// ControlPlaneWaitTimeout is how long a gateway waits for istiod to be ready before failing, 0 waits forever
+	ControlPlaneWaitTimeout time.Duration
+	// ComponentThreadiness is the number of components of a resource reconciled at the same time
+	ComponentThreadiness int
 }
 
 func DefaultControllersManagerOption() *ControllersManagerOption {
 	return &ControllersManagerOption{
-		EnableIstio:   true,
-		EnableSidecar: false,
+		EnableIstio:    true,
+		EnableSidecar:  false,
+		EnableWebhook:  false,
+		WebhookPort:    9443,
+		WebhookCertDir: "/tmp/k8s-webhook-server/serving-certs",
+		MetricsAddr:    ":8080",
+
+		ControlPlaneWaitTimeout: 5 * time.Minute,
+		ComponentThreadiness:    1,
 	}
 }
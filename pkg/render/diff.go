@@ -0,0 +1,138 @@
+/*
+Copyright 2020 The symcn authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/goph/emperror"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/istio"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/gateways"
+	"github.com/symcn/mid-operator/pkg/k8sutils"
+	"github.com/symcn/mid-operator/pkg/static"
+)
+
+// Diff reads the Istio and MeshGateway resources of the multi-document YAML and returns the changes their component
+// reconcilers would make to the cluster of the clients, each followed by the JSON patch of the updates.
+// Nothing is written to the cluster.
+func Diff(in io.Reader, c client.Client, dc dynamic.Interface, opts Options) ([]byte, error) {
+	istios, gws, remoteIstios, err := decode(in, opts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(remoteIstios) > 0 {
+		return nil, errors.New("RemoteIstio resources are not supported, their objects live in the remote clusters")
+	}
+	if len(istios)+len(gws) == 0 {
+		return nil, errors.New("no Istio or MeshGateway resource found")
+	}
+
+	var crds *k8sutils.CRDReconciler
+	if opts.IncludeCRDs {
+		istioCRDs, err := static.LoadIstioCRDs()
+		if err != nil {
+			return nil, emperror.Wrap(err, "could not load istio crds")
+		}
+		crds = k8sutils.NewCRDReconciler(c, istioCRDs...)
+	}
+
+	log := ctrllog.NullLogger{}
+	var changes []devopsv1beta1.ResourceChange
+
+	for _, config := range istios {
+		err := adoptLive(c, config)
+		if err != nil {
+			return nil, err
+		}
+		istioChanges, err := istio.DryRun(log, c, dc, crds, config)
+		changes = append(changes, istioChanges...)
+		if err != nil {
+			return nil, emperror.WrapWith(err, "could not diff istio", "name", config.Name)
+		}
+		// the CRDs are shared by every control plane
+		crds = nil
+	}
+
+	for _, gw := range gws {
+		err := adoptLive(c, gw)
+		if err != nil {
+			return nil, err
+		}
+		config := istioOfMeshGateway(istios, gw)
+		devopsv1beta1.SetDefaults(config)
+		gw.SetDefaults()
+
+		recorder := k8sutils.NewChangeRecorder()
+		err = gateways.New(k8sutils.WithDryRun(c, recorder), k8sutils.WithDynamicDryRun(dc, recorder), config, gw).Reconcile(log)
+		changes = append(changes, recorder.Changes()...)
+		if err != nil {
+			return nil, emperror.WrapWith(err, "could not diff mesh gateway", "name", gw.Name)
+		}
+	}
+
+	var out bytes.Buffer
+	for _, change := range changes {
+		name := change.Name
+		if change.Namespace != "" {
+			name = change.Namespace + "/" + name
+		}
+		fmt.Fprintf(&out, "%s %s %s\n", change.Operation, change.Kind, name)
+		if change.Patch != "" {
+			fmt.Fprintln(&out, change.Patch)
+		}
+	}
+
+	return out.Bytes(), nil
+}
+
+// adoptLive sets the UID of the resource running in the cluster on the local one, so that the owner references
+// of the objects it owns compare equal
+func adoptLive(c client.Client, o runtime.Object) error {
+	key, err := client.ObjectKeyFromObject(o)
+	if err != nil {
+		return err
+	}
+
+	live := o.DeepCopyObject()
+	err = c.Get(context.TODO(), key, live)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return emperror.WrapWith(err, "could not get live resource", "name", key.Name)
+	}
+
+	switch o := o.(type) {
+	case *devopsv1beta1.Istio:
+		o.UID = live.(*devopsv1beta1.Istio).UID
+	case *devopsv1beta1.MeshGateway:
+		o.UID = live.(*devopsv1beta1.MeshGateway).UID
+	}
+
+	return nil
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2020 The symcn authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/banzaicloud/k8s-objectmatcher/patch"
+	"github.com/ghodss/yaml"
+	"github.com/go-logr/logr"
+	"github.com/goph/emperror"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	devopsv1beta1 "github.com/symcn/mid-operator/pkg/apis/devops/v1beta1"
+	"github.com/symcn/mid-operator/pkg/controllers/istio"
+	"github.com/symcn/mid-operator/pkg/controllers/meshgateway"
+	"github.com/symcn/mid-operator/pkg/controllers/remoteistio"
+	"github.com/symcn/mid-operator/pkg/controllers/resources"
+	"github.com/symcn/mid-operator/pkg/controllers/resources/gateways"
+	"github.com/symcn/mid-operator/pkg/k8sclient"
+	"github.com/symcn/mid-operator/pkg/static"
+)
+
+// Options of the rendering
+type Options struct {
+	// Namespace is used for the resources read without one
+	Namespace string
+	// IncludeCRDs prepends the Istio CRDs to the rendered objects
+	IncludeCRDs bool
+}
+
+// renderedObject references an object created by the components, either through the typed or the dynamic client
+type renderedObject struct {
+	typed     runtime.Object
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// renderer runs the component reconcilers against in-memory clients and remembers the objects they create,
+// in the order of their creation
+type renderer struct {
+	client  client.Client
+	dynamic *dynamicfake.FakeDynamicClient
+	objects []renderedObject
+}
+
+// recordingClient records the objects created through the client
+type recordingClient struct {
+	client.Client
+	r *renderer
+}
+
+func (c *recordingClient) Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+	err := c.Client.Create(ctx, obj, opts...)
+	if err != nil {
+		return err
+	}
+
+	c.r.objects = append(c.r.objects, renderedObject{typed: obj.DeepCopyObject()})
+	return nil
+}
+
+func newRenderer() *renderer {
+	r := &renderer{
+		dynamic: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+	}
+	r.client = &recordingClient{
+		Client: fake.NewFakeClientWithScheme(k8sclient.GetScheme()),
+		r:      r,
+	}
+	r.dynamic.PrependReactor("create", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		o, ok := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		if ok {
+			r.objects = append(r.objects, renderedObject{
+				gvr:       action.GetResource(),
+				namespace: action.GetNamespace(),
+				name:      o.GetName(),
+			})
+		}
+		// the object is still created by the tracker of the fake client
+		return false, nil, nil
+	})
+
+	return r
+}
+
+// Render reads the Istio, MeshGateway and RemoteIstio resources of the multi-document YAML and returns every object
+// their component reconcilers would create as multi-document YAML. MeshGateways and RemoteIstios are rendered with
+// the Istio of their namespace read along with them, or with a defaulted one if there is none.
+func Render(in io.Reader, opts Options) ([]byte, error) {
+	istios, gws, remoteIstios, err := decode(in, opts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(istios)+len(gws)+len(remoteIstios) == 0 {
+		return nil, errors.New("no Istio, MeshGateway or RemoteIstio resource found")
+	}
+
+	r := newRenderer()
+	log := ctrllog.NullLogger{}
+
+	for _, config := range istios {
+		devopsv1beta1.SetDefaults(config)
+		err := r.reconcile(log, istio.ControlPlaneComponents(r.client, r.dynamic, config))
+		if err != nil {
+			return nil, emperror.WrapWith(err, "could not render istio", "name", config.Name)
+		}
+		// the components depending on the control plane run once it is rolled out
+		config.Status.Version = config.Spec.Version
+		err = r.reconcile(log, istio.Components(r.client, r.dynamic, config))
+		if err != nil {
+			return nil, emperror.WrapWith(err, "could not render istio", "name", config.Name)
+		}
+	}
+
+	// the gateways created by the Istio components are rendered along with the ones read
+	var created devopsv1beta1.MeshGatewayList
+	err = r.client.List(context.TODO(), &created)
+	if err != nil {
+		return nil, emperror.Wrap(err, "could not list mesh gateways")
+	}
+	for i := range created.Items {
+		gws = append(gws, &created.Items[i])
+	}
+
+	for _, gw := range gws {
+		config := istioOfMeshGateway(istios, gw)
+		gw.SetDefaults()
+		err := r.reconcile(log, []resources.Component{
+			{ConditionType: devopsv1beta1.ConditionTypeGateway, Reconciler: gateways.New(r.client, r.dynamic, config, gw)},
+		})
+		if err != nil {
+			return nil, emperror.WrapWith(err, "could not render mesh gateway", "name", gw.Name)
+		}
+	}
+
+	var crds []runtime.Object
+	if opts.IncludeCRDs {
+		istioCRDs, err := static.LoadIstioCRDs()
+		if err != nil {
+			return nil, emperror.Wrap(err, "could not load istio crds")
+		}
+		for _, crd := range istioCRDs {
+			crds = append(crds, crd)
+		}
+	}
+
+	var out bytes.Buffer
+	err = r.write(&out, crds)
+	if err != nil {
+		return nil, err
+	}
+
+	// the objects of a remote cluster are rendered apart, the primary cluster is only read by the remote components
+	for _, remoteConfig := range remoteIstios {
+		devopsv1beta1.SetRemoteIstioDefaults(remoteConfig)
+		config := remoteistio.RemoteIstioConfig(istioOfNamespace(istios, remoteConfig.Namespace), remoteConfig)
+		remote := newRenderer()
+		err := remote.reconcile(log, remoteistio.Components(r.client, remote.client, config, remoteConfig))
+		if err != nil {
+			return nil, emperror.WrapWith(err, "could not render remote istio", "name", remoteConfig.Name)
+		}
+
+		fmt.Fprintf(&out, "# remote cluster %s/%s\n", remoteConfig.Namespace, remoteConfig.Name)
+		err = remote.write(&out, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return out.Bytes(), nil
+}
+
+// write prints the given objects followed by the created ones
+func (r *renderer) write(out *bytes.Buffer, objects []runtime.Object) error {
+	rendered, err := r.renderedObjects()
+	if err != nil {
+		return err
+	}
+
+	return marshal(out, append(objects, rendered...))
+}
+
+func (r *renderer) reconcile(log logr.Logger, components []resources.Component) error {
+	for _, component := range components {
+		err := component.Reconciler.Reconcile(log)
+		if err != nil {
+			return emperror.Wrapf(err, "could not reconcile component '%s'", component.ConditionType)
+		}
+	}
+
+	return nil
+}
+
+// renderedObjects returns the current state of the created objects, the ones deleted since are left out
+func (r *renderer) renderedObjects() ([]runtime.Object, error) {
+	objects := make([]runtime.Object, 0, len(r.objects))
+	for _, o := range r.objects {
+		if o.typed == nil {
+			current, err := r.dynamic.Resource(o.gvr).Namespace(o.namespace).Get(o.name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, emperror.WrapWith(err, "could not get rendered object", "resource", o.gvr, "name", o.name)
+			}
+			objects = append(objects, current)
+			continue
+		}
+
+		key, err := client.ObjectKeyFromObject(o.typed)
+		if err != nil {
+			return nil, err
+		}
+		current := o.typed.DeepCopyObject()
+		err = r.client.Get(context.TODO(), key, current)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, emperror.WrapWith(err, "could not get rendered object", "name", key.Name)
+		}
+		objects = append(objects, current)
+	}
+
+	return objects, nil
+}
+
+// marshal prints the objects as multi-document YAML, without the fields set by the cluster or the operator
+func marshal(out *bytes.Buffer, objects []runtime.Object) error {
+	for _, o := range objects {
+		// the type meta of typed objects is usually left empty
+		gvk, err := apiutil.GVKForObject(o, k8sclient.GetScheme())
+		if err != nil {
+			return emperror.Wrap(err, "could not get kind of object")
+		}
+		o.GetObjectKind().SetGroupVersionKind(gvk)
+
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return emperror.Wrap(err, "could not convert object")
+		}
+		obj := &unstructured.Unstructured{Object: u}
+
+		annotations := obj.GetAnnotations()
+		delete(annotations, patch.LastAppliedConfig)
+		if len(annotations) == 0 {
+			unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+		} else {
+			obj.SetAnnotations(annotations)
+		}
+		obj.SetResourceVersion("")
+		obj.SetOwnerReferences(nil)
+		unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(obj.Object, "status")
+
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return emperror.Wrap(err, "could not marshal object")
+		}
+		out.WriteString("---\n")
+		out.Write(data)
+	}
+
+	return nil
+}
+
+// decode reads the Istio, MeshGateway and RemoteIstio resources of the multi-document YAML, the other kinds are ignored
+func decode(in io.Reader, namespace string) ([]*devopsv1beta1.Istio, []*devopsv1beta1.MeshGateway, []*devopsv1beta1.RemoteIstio, error) {
+	var istios []*devopsv1beta1.Istio
+	var gws []*devopsv1beta1.MeshGateway
+	var remoteIstios []*devopsv1beta1.RemoteIstio
+
+	decoder := serializer.NewCodecFactory(k8sclient.GetScheme()).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(in))
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, emperror.Wrap(err, "could not read yaml document")
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		o, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, nil, nil, emperror.Wrap(err, "could not decode yaml document")
+		}
+
+		switch o := o.(type) {
+		case *devopsv1beta1.Istio:
+			setNamespace(o, namespace)
+			istios = append(istios, o)
+		case *devopsv1beta1.MeshGateway:
+			setNamespace(o, namespace)
+			gws = append(gws, o)
+		case *devopsv1beta1.RemoteIstio:
+			setNamespace(o, namespace)
+			remoteIstios = append(remoteIstios, o)
+		}
+	}
+
+	return istios, gws, remoteIstios, nil
+}
+
+func setNamespace(o metav1.Object, namespace string) {
+	if o.GetNamespace() == "" {
+		o.SetNamespace(namespace)
+	}
+}
+
+// istioOfNamespace returns the Istio read in the namespace, or a defaulted one if there is none
+func istioOfNamespace(istios []*devopsv1beta1.Istio, namespace string) *devopsv1beta1.Istio {
+	for _, config := range istios {
+		if config.Namespace == namespace {
+			return config
+		}
+	}
+
+	config := &devopsv1beta1.Istio{}
+	config.Namespace = namespace
+	devopsv1beta1.SetDefaults(config)
+
+	return config
+}
+
+// istioOfMeshGateway returns the Istio read the gateway belongs to, or a defaulted one of its namespace if there is none
+func istioOfMeshGateway(istios []*devopsv1beta1.Istio, gw *devopsv1beta1.MeshGateway) *devopsv1beta1.Istio {
+	items := make([]devopsv1beta1.Istio, 0, len(istios))
+	for _, config := range istios {
+		items = append(items, *config)
+	}
+
+	config, err := meshgateway.ControlPlaneOf(gw, items)
+	if err != nil {
+		return istioOfNamespace(nil, gw.Namespace)
+	}
+
+	return config
+}
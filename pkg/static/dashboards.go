@@ -0,0 +1,41 @@
+package static
+
+import (
+	"io/ioutil"
+	"path"
+
+	"github.com/pkg/errors"
+
+	dashboards "github.com/symcn/mid-operator/pkg/static/grafana-dashboards/generated"
+)
+
+// LoadGrafanaDashboards returns the Grafana dashboards bundled for the given Istio minor version, keyed by file name
+func LoadGrafanaDashboards(minorVersion string) (map[string]string, error) {
+	dir, err := dashboards.GrafanaDashboards.Open(path.Join("/", minorVersion))
+	if err != nil {
+		return nil, errors.Wrapf(err, "no grafana dashboards bundled for Istio %s", minorVersion)
+	}
+	defer dir.Close()
+
+	dirFiles, err := dir.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(dirFiles))
+	for _, file := range dirFiles {
+		f, err := dashboards.GrafanaDashboards.Open(path.Join("/", minorVersion, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		result[file.Name()] = string(content)
+	}
+
+	return result, nil
+}
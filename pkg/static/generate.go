@@ -18,6 +18,8 @@ var IstioCRDs http.FileSystem = http.Dir(path.Join(getRepoRoot(), "pkg/static/is
 
 var MidCRDs http.FileSystem = http.Dir(path.Join(getRepoRoot(), "config/crd/bases"))
 
+var GrafanaDashboards http.FileSystem = http.Dir(path.Join(getRepoRoot(), "pkg/static/grafana-dashboards/assets"))
+
 func main() {
 	rootDir := getRepoRoot()
 	log.Printf("rootDir: %s", rootDir)
@@ -39,6 +41,15 @@ func main() {
 	if err != nil {
 		log.Fatalln(err)
 	}
+
+	err = vfsgen.Generate(GrafanaDashboards, vfsgen.Options{
+		Filename:     path.Join(rootDir, "pkg/static/grafana-dashboards/generated/grafana-dashboards.gogen.go"),
+		PackageName:  "generated",
+		VariableName: "GrafanaDashboards",
+	})
+	if err != nil {
+		log.Fatalln(err)
+	}
 }
 
 // getRepoRoot returns the full path to the root of the repo
@@ -9,8 +9,23 @@ const (
 	ComponentNameCrd = "crds"
 	CreatedByLabel   = "symcn.io/created-by"
 	CreatedBy        = "mid-operator"
+
+	// Cluster scoped objects cannot carry an owner reference to a namespaced owner,
+	// so their owner is referenced through labels as well
+	OwnerKindLabel      = "symcn.io/owner-kind"
+	OwnerNameLabel      = "symcn.io/owner-name"
+	OwnerNamespaceLabel = "symcn.io/owner-namespace"
 )
 
+// OwnerLabels returns the labels referencing the owner of a cluster scoped object
+func OwnerLabels(kind, name, namespace string) map[string]string {
+	return map[string]string{
+		OwnerKindLabel:      kind,
+		OwnerNameLabel:      name,
+		OwnerNamespaceLabel: namespace,
+	}
+}
+
 func GetWatchPredicateForCRDs() predicate.Funcs {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {